@@ -36,26 +36,89 @@ type ReservationService interface {
 	// Provider sets their availability
 	SetAvailability(context.Context, *SetAvailabilityRequest) (*SetAvailabilityResponse, error)
 
+	// Shrink an availability window, removing the unreserved slots outside it
+	UpdateAvailability(context.Context, *UpdateAvailabilityRequest) (*UpdateAvailabilityResponse, error)
+
+	// Remove an availability window and its unreserved slots
+	RemoveAvailability(context.Context, *RemoveAvailabilityRequest) (*RemoveAvailabilityResponse, error)
+
+	// Provider creates a recurring weekly availability rule
+	CreateAvailabilityRule(context.Context, *CreateAvailabilityRuleRequest) (*CreateAvailabilityRuleResponse, error)
+
+	// Retrieve a provider's availability rules
+	ListAvailabilityRules(context.Context, *ListAvailabilityRulesRequest) (*ListAvailabilityRulesResponse, error)
+
+	// Delete an availability rule and its future unreserved slots
+	DeleteAvailabilityRule(context.Context, *DeleteAvailabilityRuleRequest) (*DeleteAvailabilityRuleResponse, error)
+
+	// Stop an availability rule from applying on a day and remove its unreserved slots that day
+	AddAvailabilityRuleException(context.Context, *AddAvailabilityRuleExceptionRequest) (*AddAvailabilityRuleExceptionResponse, error)
+
+	// Add an appointment type to a provider's catalog
+	CreateAppointmentType(context.Context, *CreateAppointmentTypeRequest) (*CreateAppointmentTypeResponse, error)
+
+	// Retrieve a provider's appointment types
+	ListAppointmentTypes(context.Context, *ListAppointmentTypesRequest) (*ListAppointmentTypesResponse, error)
+
+	// Remove an appointment type from a provider's catalog
+	DeleteAppointmentType(context.Context, *DeleteAppointmentTypeRequest) (*DeleteAppointmentTypeResponse, error)
+
 	// Retrieve available slots
 	GetAvailableSlots(context.Context, *GetAvailableSlotsRequest) (*GetAvailableSlotsResponse, error)
 
 	// Reserve a slot
 	ReserveSlot(context.Context, *ReserveSlotRequest) (*ReserveSlotResponse, error)
 
-	// Confirm a reservation
+	// Confirm a held reservation with its hold token
 	ConfirmReservation(context.Context, *ConfirmReservationRequest) (*ConfirmReservationResponse, error)
 
+	// Extend the hold of an unconfirmed reservation
+	ExtendHold(context.Context, *ExtendHoldRequest) (*ExtendHoldResponse, error)
+
+	// Cancel a reservation and return its slot to the pool
+	CancelReservation(context.Context, *CancelReservationRequest) (*CancelReservationResponse, error)
+
+	// Move a reservation to another slot
+	RescheduleReservation(context.Context, *RescheduleReservationRequest) (*RescheduleReservationResponse, error)
+
 	// Create a new provider
 	CreateProvider(context.Context, *CreateProviderRequest) (*CreateProviderResponse, error)
 
 	// Retrieve provider data
 	GetProvider(context.Context, *GetProviderRequest) (*GetProviderResponse, error)
 
+	// Update a provider's name and slot settings
+	UpdateProvider(context.Context, *UpdateProviderRequest) (*UpdateProviderResponse, error)
+
+	// Stop a provider from being booked, optionally cancelling their future reservations
+	DeactivateProvider(context.Context, *DeactivateProviderRequest) (*DeactivateProviderResponse, error)
+
+	// Retrieve all providers
+	ListProviders(context.Context, *ListProvidersRequest) (*ListProvidersResponse, error)
+
+	// Register a new client
+	CreateClient(context.Context, *CreateClientRequest) (*CreateClientResponse, error)
+
+	// Retrieve client data
+	GetClient(context.Context, *GetClientRequest) (*GetClientResponse, error)
+
+	// Update a client's name and contact details
+	UpdateClient(context.Context, *UpdateClientRequest) (*UpdateClientResponse, error)
+
 	// Retrieve reservations by Provider
 	GetReservedSlotsByProvider(context.Context, *GetReservedSlotsByProviderRequest) (*GetReservedSlotsByProviderResponse, error)
 
 	// Retrieve reservations by Client
 	GetReservedSlotsByClient(context.Context, *GetReservedSlotsByClientRequest) (*GetReservedSlotsByClientResponse, error)
+
+	// Wait for a provider's slot to free up
+	JoinWaitlist(context.Context, *JoinWaitlistRequest) (*JoinWaitlistResponse, error)
+
+	// Retrieve a client's waitlist entries and their offers
+	GetWaitlistByClient(context.Context, *GetWaitlistByClientRequest) (*GetWaitlistByClientResponse, error)
+
+	// Confirm the slot offered to a waitlist entry
+	AcceptWaitlistOffer(context.Context, *AcceptWaitlistOfferRequest) (*AcceptWaitlistOfferResponse, error)
 }
 
 // ==================================
@@ -64,7 +127,7 @@ type ReservationService interface {
 
 type reservationServiceProtobufClient struct {
 	client      HTTPClient
-	urls        [8]string
+	urls        [29]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -92,15 +155,36 @@ func NewReservationServiceProtobufClient(baseURL string, client HTTPClient, opts
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "reservation", "ReservationService")
-	urls := [8]string{
+	urls := [29]string{
 		serviceURL + "SetAvailability",
+		serviceURL + "UpdateAvailability",
+		serviceURL + "RemoveAvailability",
+		serviceURL + "CreateAvailabilityRule",
+		serviceURL + "ListAvailabilityRules",
+		serviceURL + "DeleteAvailabilityRule",
+		serviceURL + "AddAvailabilityRuleException",
+		serviceURL + "CreateAppointmentType",
+		serviceURL + "ListAppointmentTypes",
+		serviceURL + "DeleteAppointmentType",
 		serviceURL + "GetAvailableSlots",
 		serviceURL + "ReserveSlot",
 		serviceURL + "ConfirmReservation",
+		serviceURL + "ExtendHold",
+		serviceURL + "CancelReservation",
+		serviceURL + "RescheduleReservation",
 		serviceURL + "CreateProvider",
 		serviceURL + "GetProvider",
+		serviceURL + "UpdateProvider",
+		serviceURL + "DeactivateProvider",
+		serviceURL + "ListProviders",
+		serviceURL + "CreateClient",
+		serviceURL + "GetClient",
+		serviceURL + "UpdateClient",
 		serviceURL + "GetReservedSlotsByProvider",
 		serviceURL + "GetReservedSlotsByClient",
+		serviceURL + "JoinWaitlist",
+		serviceURL + "GetWaitlistByClient",
+		serviceURL + "AcceptWaitlistOffer",
 	}
 
 	return &reservationServiceProtobufClient{
@@ -157,26 +241,26 @@ func (c *reservationServiceProtobufClient) callSetAvailability(ctx context.Conte
 	return out, nil
 }
 
-func (c *reservationServiceProtobufClient) GetAvailableSlots(ctx context.Context, in *GetAvailableSlotsRequest) (*GetAvailableSlotsResponse, error) {
+func (c *reservationServiceProtobufClient) UpdateAvailability(ctx context.Context, in *UpdateAvailabilityRequest) (*UpdateAvailabilityResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "reservation")
 	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
-	ctx = ctxsetters.WithMethodName(ctx, "GetAvailableSlots")
-	caller := c.callGetAvailableSlots
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateAvailability")
+	caller := c.callUpdateAvailability
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *GetAvailableSlotsRequest) (*GetAvailableSlotsResponse, error) {
+		caller = func(ctx context.Context, req *UpdateAvailabilityRequest) (*UpdateAvailabilityResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetAvailableSlotsRequest)
+					typedReq, ok := req.(*UpdateAvailabilityRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetAvailableSlotsRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateAvailabilityRequest) when calling interceptor")
 					}
-					return c.callGetAvailableSlots(ctx, typedReq)
+					return c.callUpdateAvailability(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetAvailableSlotsResponse)
+				typedResp, ok := resp.(*UpdateAvailabilityResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetAvailableSlotsResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateAvailabilityResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -186,8 +270,8 @@ func (c *reservationServiceProtobufClient) GetAvailableSlots(ctx context.Context
 	return caller(ctx, in)
 }
 
-func (c *reservationServiceProtobufClient) callGetAvailableSlots(ctx context.Context, in *GetAvailableSlotsRequest) (*GetAvailableSlotsResponse, error) {
-	out := new(GetAvailableSlotsResponse)
+func (c *reservationServiceProtobufClient) callUpdateAvailability(ctx context.Context, in *UpdateAvailabilityRequest) (*UpdateAvailabilityResponse, error) {
+	out := new(UpdateAvailabilityResponse)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -203,26 +287,26 @@ func (c *reservationServiceProtobufClient) callGetAvailableSlots(ctx context.Con
 	return out, nil
 }
 
-func (c *reservationServiceProtobufClient) ReserveSlot(ctx context.Context, in *ReserveSlotRequest) (*ReserveSlotResponse, error) {
+func (c *reservationServiceProtobufClient) RemoveAvailability(ctx context.Context, in *RemoveAvailabilityRequest) (*RemoveAvailabilityResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "reservation")
 	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
-	ctx = ctxsetters.WithMethodName(ctx, "ReserveSlot")
-	caller := c.callReserveSlot
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveAvailability")
+	caller := c.callRemoveAvailability
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *ReserveSlotRequest) (*ReserveSlotResponse, error) {
+		caller = func(ctx context.Context, req *RemoveAvailabilityRequest) (*RemoveAvailabilityResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ReserveSlotRequest)
+					typedReq, ok := req.(*RemoveAvailabilityRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ReserveSlotRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RemoveAvailabilityRequest) when calling interceptor")
 					}
-					return c.callReserveSlot(ctx, typedReq)
+					return c.callRemoveAvailability(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ReserveSlotResponse)
+				typedResp, ok := resp.(*RemoveAvailabilityResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ReserveSlotResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*RemoveAvailabilityResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -232,8 +316,8 @@ func (c *reservationServiceProtobufClient) ReserveSlot(ctx context.Context, in *
 	return caller(ctx, in)
 }
 
-func (c *reservationServiceProtobufClient) callReserveSlot(ctx context.Context, in *ReserveSlotRequest) (*ReserveSlotResponse, error) {
-	out := new(ReserveSlotResponse)
+func (c *reservationServiceProtobufClient) callRemoveAvailability(ctx context.Context, in *RemoveAvailabilityRequest) (*RemoveAvailabilityResponse, error) {
+	out := new(RemoveAvailabilityResponse)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -249,26 +333,26 @@ func (c *reservationServiceProtobufClient) callReserveSlot(ctx context.Context,
 	return out, nil
 }
 
-func (c *reservationServiceProtobufClient) ConfirmReservation(ctx context.Context, in *ConfirmReservationRequest) (*ConfirmReservationResponse, error) {
+func (c *reservationServiceProtobufClient) CreateAvailabilityRule(ctx context.Context, in *CreateAvailabilityRuleRequest) (*CreateAvailabilityRuleResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "reservation")
 	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
-	ctx = ctxsetters.WithMethodName(ctx, "ConfirmReservation")
-	caller := c.callConfirmReservation
+	ctx = ctxsetters.WithMethodName(ctx, "CreateAvailabilityRule")
+	caller := c.callCreateAvailabilityRule
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *ConfirmReservationRequest) (*ConfirmReservationResponse, error) {
+		caller = func(ctx context.Context, req *CreateAvailabilityRuleRequest) (*CreateAvailabilityRuleResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ConfirmReservationRequest)
+					typedReq, ok := req.(*CreateAvailabilityRuleRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ConfirmReservationRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*CreateAvailabilityRuleRequest) when calling interceptor")
 					}
-					return c.callConfirmReservation(ctx, typedReq)
+					return c.callCreateAvailabilityRule(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ConfirmReservationResponse)
+				typedResp, ok := resp.(*CreateAvailabilityRuleResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ConfirmReservationResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateAvailabilityRuleResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -278,8 +362,8 @@ func (c *reservationServiceProtobufClient) ConfirmReservation(ctx context.Contex
 	return caller(ctx, in)
 }
 
-func (c *reservationServiceProtobufClient) callConfirmReservation(ctx context.Context, in *ConfirmReservationRequest) (*ConfirmReservationResponse, error) {
-	out := new(ConfirmReservationResponse)
+func (c *reservationServiceProtobufClient) callCreateAvailabilityRule(ctx context.Context, in *CreateAvailabilityRuleRequest) (*CreateAvailabilityRuleResponse, error) {
+	out := new(CreateAvailabilityRuleResponse)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -295,26 +379,26 @@ func (c *reservationServiceProtobufClient) callConfirmReservation(ctx context.Co
 	return out, nil
 }
 
-func (c *reservationServiceProtobufClient) CreateProvider(ctx context.Context, in *CreateProviderRequest) (*CreateProviderResponse, error) {
+func (c *reservationServiceProtobufClient) ListAvailabilityRules(ctx context.Context, in *ListAvailabilityRulesRequest) (*ListAvailabilityRulesResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "reservation")
 	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
-	ctx = ctxsetters.WithMethodName(ctx, "CreateProvider")
-	caller := c.callCreateProvider
+	ctx = ctxsetters.WithMethodName(ctx, "ListAvailabilityRules")
+	caller := c.callListAvailabilityRules
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *CreateProviderRequest) (*CreateProviderResponse, error) {
+		caller = func(ctx context.Context, req *ListAvailabilityRulesRequest) (*ListAvailabilityRulesResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreateProviderRequest)
+					typedReq, ok := req.(*ListAvailabilityRulesRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreateProviderRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListAvailabilityRulesRequest) when calling interceptor")
 					}
-					return c.callCreateProvider(ctx, typedReq)
+					return c.callListAvailabilityRules(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*CreateProviderResponse)
+				typedResp, ok := resp.(*ListAvailabilityRulesResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*CreateProviderResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListAvailabilityRulesResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -324,8 +408,8 @@ func (c *reservationServiceProtobufClient) CreateProvider(ctx context.Context, i
 	return caller(ctx, in)
 }
 
-func (c *reservationServiceProtobufClient) callCreateProvider(ctx context.Context, in *CreateProviderRequest) (*CreateProviderResponse, error) {
-	out := new(CreateProviderResponse)
+func (c *reservationServiceProtobufClient) callListAvailabilityRules(ctx context.Context, in *ListAvailabilityRulesRequest) (*ListAvailabilityRulesResponse, error) {
+	out := new(ListAvailabilityRulesResponse)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -341,26 +425,26 @@ func (c *reservationServiceProtobufClient) callCreateProvider(ctx context.Contex
 	return out, nil
 }
 
-func (c *reservationServiceProtobufClient) GetProvider(ctx context.Context, in *GetProviderRequest) (*GetProviderResponse, error) {
+func (c *reservationServiceProtobufClient) DeleteAvailabilityRule(ctx context.Context, in *DeleteAvailabilityRuleRequest) (*DeleteAvailabilityRuleResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "reservation")
 	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
-	ctx = ctxsetters.WithMethodName(ctx, "GetProvider")
-	caller := c.callGetProvider
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteAvailabilityRule")
+	caller := c.callDeleteAvailabilityRule
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *GetProviderRequest) (*GetProviderResponse, error) {
+		caller = func(ctx context.Context, req *DeleteAvailabilityRuleRequest) (*DeleteAvailabilityRuleResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetProviderRequest)
+					typedReq, ok := req.(*DeleteAvailabilityRuleRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetProviderRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteAvailabilityRuleRequest) when calling interceptor")
 					}
-					return c.callGetProvider(ctx, typedReq)
+					return c.callDeleteAvailabilityRule(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetProviderResponse)
+				typedResp, ok := resp.(*DeleteAvailabilityRuleResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetProviderResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteAvailabilityRuleResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -370,8 +454,8 @@ func (c *reservationServiceProtobufClient) GetProvider(ctx context.Context, in *
 	return caller(ctx, in)
 }
 
-func (c *reservationServiceProtobufClient) callGetProvider(ctx context.Context, in *GetProviderRequest) (*GetProviderResponse, error) {
-	out := new(GetProviderResponse)
+func (c *reservationServiceProtobufClient) callDeleteAvailabilityRule(ctx context.Context, in *DeleteAvailabilityRuleRequest) (*DeleteAvailabilityRuleResponse, error) {
+	out := new(DeleteAvailabilityRuleResponse)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -387,26 +471,26 @@ func (c *reservationServiceProtobufClient) callGetProvider(ctx context.Context,
 	return out, nil
 }
 
-func (c *reservationServiceProtobufClient) GetReservedSlotsByProvider(ctx context.Context, in *GetReservedSlotsByProviderRequest) (*GetReservedSlotsByProviderResponse, error) {
+func (c *reservationServiceProtobufClient) AddAvailabilityRuleException(ctx context.Context, in *AddAvailabilityRuleExceptionRequest) (*AddAvailabilityRuleExceptionResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "reservation")
 	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
-	ctx = ctxsetters.WithMethodName(ctx, "GetReservedSlotsByProvider")
-	caller := c.callGetReservedSlotsByProvider
+	ctx = ctxsetters.WithMethodName(ctx, "AddAvailabilityRuleException")
+	caller := c.callAddAvailabilityRuleException
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *GetReservedSlotsByProviderRequest) (*GetReservedSlotsByProviderResponse, error) {
+		caller = func(ctx context.Context, req *AddAvailabilityRuleExceptionRequest) (*AddAvailabilityRuleExceptionResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetReservedSlotsByProviderRequest)
+					typedReq, ok := req.(*AddAvailabilityRuleExceptionRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetReservedSlotsByProviderRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AddAvailabilityRuleExceptionRequest) when calling interceptor")
 					}
-					return c.callGetReservedSlotsByProvider(ctx, typedReq)
+					return c.callAddAvailabilityRuleException(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetReservedSlotsByProviderResponse)
+				typedResp, ok := resp.(*AddAvailabilityRuleExceptionResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetReservedSlotsByProviderResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*AddAvailabilityRuleExceptionResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -416,8 +500,8 @@ func (c *reservationServiceProtobufClient) GetReservedSlotsByProvider(ctx contex
 	return caller(ctx, in)
 }
 
-func (c *reservationServiceProtobufClient) callGetReservedSlotsByProvider(ctx context.Context, in *GetReservedSlotsByProviderRequest) (*GetReservedSlotsByProviderResponse, error) {
-	out := new(GetReservedSlotsByProviderResponse)
+func (c *reservationServiceProtobufClient) callAddAvailabilityRuleException(ctx context.Context, in *AddAvailabilityRuleExceptionRequest) (*AddAvailabilityRuleExceptionResponse, error) {
+	out := new(AddAvailabilityRuleExceptionResponse)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -433,26 +517,26 @@ func (c *reservationServiceProtobufClient) callGetReservedSlotsByProvider(ctx co
 	return out, nil
 }
 
-func (c *reservationServiceProtobufClient) GetReservedSlotsByClient(ctx context.Context, in *GetReservedSlotsByClientRequest) (*GetReservedSlotsByClientResponse, error) {
+func (c *reservationServiceProtobufClient) CreateAppointmentType(ctx context.Context, in *CreateAppointmentTypeRequest) (*CreateAppointmentTypeResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "reservation")
 	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
-	ctx = ctxsetters.WithMethodName(ctx, "GetReservedSlotsByClient")
-	caller := c.callGetReservedSlotsByClient
+	ctx = ctxsetters.WithMethodName(ctx, "CreateAppointmentType")
+	caller := c.callCreateAppointmentType
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *GetReservedSlotsByClientRequest) (*GetReservedSlotsByClientResponse, error) {
+		caller = func(ctx context.Context, req *CreateAppointmentTypeRequest) (*CreateAppointmentTypeResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetReservedSlotsByClientRequest)
+					typedReq, ok := req.(*CreateAppointmentTypeRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetReservedSlotsByClientRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*CreateAppointmentTypeRequest) when calling interceptor")
 					}
-					return c.callGetReservedSlotsByClient(ctx, typedReq)
+					return c.callCreateAppointmentType(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetReservedSlotsByClientResponse)
+				typedResp, ok := resp.(*CreateAppointmentTypeResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetReservedSlotsByClientResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateAppointmentTypeResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -462,8 +546,8 @@ func (c *reservationServiceProtobufClient) GetReservedSlotsByClient(ctx context.
 	return caller(ctx, in)
 }
 
-func (c *reservationServiceProtobufClient) callGetReservedSlotsByClient(ctx context.Context, in *GetReservedSlotsByClientRequest) (*GetReservedSlotsByClientResponse, error) {
-	out := new(GetReservedSlotsByClientResponse)
+func (c *reservationServiceProtobufClient) callCreateAppointmentType(ctx context.Context, in *CreateAppointmentTypeRequest) (*CreateAppointmentTypeResponse, error) {
+	out := new(CreateAppointmentTypeResponse)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -479,79 +563,72 @@ func (c *reservationServiceProtobufClient) callGetReservedSlotsByClient(ctx cont
 	return out, nil
 }
 
-// ==============================
-// ReservationService JSON Client
-// ==============================
-
-type reservationServiceJSONClient struct {
-	client      HTTPClient
-	urls        [8]string
-	interceptor twirp.Interceptor
-	opts        twirp.ClientOptions
-}
-
-// NewReservationServiceJSONClient creates a JSON client that implements the ReservationService interface.
-// It communicates using JSON and can be configured with a custom HTTPClient.
-func NewReservationServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) ReservationService {
-	if c, ok := client.(*http.Client); ok {
-		client = withoutRedirects(c)
-	}
-
-	clientOpts := twirp.ClientOptions{}
-	for _, o := range opts {
-		o(&clientOpts)
+func (c *reservationServiceProtobufClient) ListAppointmentTypes(ctx context.Context, in *ListAppointmentTypesRequest) (*ListAppointmentTypesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListAppointmentTypes")
+	caller := c.callListAppointmentTypes
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListAppointmentTypesRequest) (*ListAppointmentTypesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListAppointmentTypesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListAppointmentTypesRequest) when calling interceptor")
+					}
+					return c.callListAppointmentTypes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListAppointmentTypesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListAppointmentTypesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
+	return caller(ctx, in)
+}
 
-	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
-	literalURLs := false
-	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
-	var pathPrefix string
-	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+func (c *reservationServiceProtobufClient) callListAppointmentTypes(ctx context.Context, in *ListAppointmentTypesRequest) (*ListAppointmentTypesResponse, error) {
+	out := new(ListAppointmentTypesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
-	serviceURL := sanitizeBaseURL(baseURL)
-	serviceURL += baseServicePath(pathPrefix, "reservation", "ReservationService")
-	urls := [8]string{
-		serviceURL + "SetAvailability",
-		serviceURL + "GetAvailableSlots",
-		serviceURL + "ReserveSlot",
-		serviceURL + "ConfirmReservation",
-		serviceURL + "CreateProvider",
-		serviceURL + "GetProvider",
-		serviceURL + "GetReservedSlotsByProvider",
-		serviceURL + "GetReservedSlotsByClient",
-	}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	return &reservationServiceJSONClient{
-		client:      client,
-		urls:        urls,
-		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
-		opts:        clientOpts,
-	}
+	return out, nil
 }
 
-func (c *reservationServiceJSONClient) SetAvailability(ctx context.Context, in *SetAvailabilityRequest) (*SetAvailabilityResponse, error) {
+func (c *reservationServiceProtobufClient) DeleteAppointmentType(ctx context.Context, in *DeleteAppointmentTypeRequest) (*DeleteAppointmentTypeResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "reservation")
 	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
-	ctx = ctxsetters.WithMethodName(ctx, "SetAvailability")
-	caller := c.callSetAvailability
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteAppointmentType")
+	caller := c.callDeleteAppointmentType
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *SetAvailabilityRequest) (*SetAvailabilityResponse, error) {
+		caller = func(ctx context.Context, req *DeleteAppointmentTypeRequest) (*DeleteAppointmentTypeResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*SetAvailabilityRequest)
+					typedReq, ok := req.(*DeleteAppointmentTypeRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*SetAvailabilityRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteAppointmentTypeRequest) when calling interceptor")
 					}
-					return c.callSetAvailability(ctx, typedReq)
+					return c.callDeleteAppointmentType(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*SetAvailabilityResponse)
+				typedResp, ok := resp.(*DeleteAppointmentTypeResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*SetAvailabilityResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteAppointmentTypeResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -561,9 +638,9 @@ func (c *reservationServiceJSONClient) SetAvailability(ctx context.Context, in *
 	return caller(ctx, in)
 }
 
-func (c *reservationServiceJSONClient) callSetAvailability(ctx context.Context, in *SetAvailabilityRequest) (*SetAvailabilityResponse, error) {
-	out := new(SetAvailabilityResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+func (c *reservationServiceProtobufClient) callDeleteAppointmentType(ctx context.Context, in *DeleteAppointmentTypeRequest) (*DeleteAppointmentTypeResponse, error) {
+	out := new(DeleteAppointmentTypeResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -578,7 +655,7 @@ func (c *reservationServiceJSONClient) callSetAvailability(ctx context.Context,
 	return out, nil
 }
 
-func (c *reservationServiceJSONClient) GetAvailableSlots(ctx context.Context, in *GetAvailableSlotsRequest) (*GetAvailableSlotsResponse, error) {
+func (c *reservationServiceProtobufClient) GetAvailableSlots(ctx context.Context, in *GetAvailableSlotsRequest) (*GetAvailableSlotsResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "reservation")
 	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
 	ctx = ctxsetters.WithMethodName(ctx, "GetAvailableSlots")
@@ -607,9 +684,9 @@ func (c *reservationServiceJSONClient) GetAvailableSlots(ctx context.Context, in
 	return caller(ctx, in)
 }
 
-func (c *reservationServiceJSONClient) callGetAvailableSlots(ctx context.Context, in *GetAvailableSlotsRequest) (*GetAvailableSlotsResponse, error) {
+func (c *reservationServiceProtobufClient) callGetAvailableSlots(ctx context.Context, in *GetAvailableSlotsRequest) (*GetAvailableSlotsResponse, error) {
 	out := new(GetAvailableSlotsResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -624,7 +701,7 @@ func (c *reservationServiceJSONClient) callGetAvailableSlots(ctx context.Context
 	return out, nil
 }
 
-func (c *reservationServiceJSONClient) ReserveSlot(ctx context.Context, in *ReserveSlotRequest) (*ReserveSlotResponse, error) {
+func (c *reservationServiceProtobufClient) ReserveSlot(ctx context.Context, in *ReserveSlotRequest) (*ReserveSlotResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "reservation")
 	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
 	ctx = ctxsetters.WithMethodName(ctx, "ReserveSlot")
@@ -653,9 +730,9 @@ func (c *reservationServiceJSONClient) ReserveSlot(ctx context.Context, in *Rese
 	return caller(ctx, in)
 }
 
-func (c *reservationServiceJSONClient) callReserveSlot(ctx context.Context, in *ReserveSlotRequest) (*ReserveSlotResponse, error) {
+func (c *reservationServiceProtobufClient) callReserveSlot(ctx context.Context, in *ReserveSlotRequest) (*ReserveSlotResponse, error) {
 	out := new(ReserveSlotResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -670,7 +747,7 @@ func (c *reservationServiceJSONClient) callReserveSlot(ctx context.Context, in *
 	return out, nil
 }
 
-func (c *reservationServiceJSONClient) ConfirmReservation(ctx context.Context, in *ConfirmReservationRequest) (*ConfirmReservationResponse, error) {
+func (c *reservationServiceProtobufClient) ConfirmReservation(ctx context.Context, in *ConfirmReservationRequest) (*ConfirmReservationResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "reservation")
 	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
 	ctx = ctxsetters.WithMethodName(ctx, "ConfirmReservation")
@@ -699,9 +776,9 @@ func (c *reservationServiceJSONClient) ConfirmReservation(ctx context.Context, i
 	return caller(ctx, in)
 }
 
-func (c *reservationServiceJSONClient) callConfirmReservation(ctx context.Context, in *ConfirmReservationRequest) (*ConfirmReservationResponse, error) {
+func (c *reservationServiceProtobufClient) callConfirmReservation(ctx context.Context, in *ConfirmReservationRequest) (*ConfirmReservationResponse, error) {
 	out := new(ConfirmReservationResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -716,26 +793,26 @@ func (c *reservationServiceJSONClient) callConfirmReservation(ctx context.Contex
 	return out, nil
 }
 
-func (c *reservationServiceJSONClient) CreateProvider(ctx context.Context, in *CreateProviderRequest) (*CreateProviderResponse, error) {
+func (c *reservationServiceProtobufClient) ExtendHold(ctx context.Context, in *ExtendHoldRequest) (*ExtendHoldResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "reservation")
 	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
-	ctx = ctxsetters.WithMethodName(ctx, "CreateProvider")
-	caller := c.callCreateProvider
+	ctx = ctxsetters.WithMethodName(ctx, "ExtendHold")
+	caller := c.callExtendHold
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *CreateProviderRequest) (*CreateProviderResponse, error) {
+		caller = func(ctx context.Context, req *ExtendHoldRequest) (*ExtendHoldResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreateProviderRequest)
+					typedReq, ok := req.(*ExtendHoldRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreateProviderRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ExtendHoldRequest) when calling interceptor")
 					}
-					return c.callCreateProvider(ctx, typedReq)
+					return c.callExtendHold(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*CreateProviderResponse)
+				typedResp, ok := resp.(*ExtendHoldResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*CreateProviderResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ExtendHoldResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -745,9 +822,9 @@ func (c *reservationServiceJSONClient) CreateProvider(ctx context.Context, in *C
 	return caller(ctx, in)
 }
 
-func (c *reservationServiceJSONClient) callCreateProvider(ctx context.Context, in *CreateProviderRequest) (*CreateProviderResponse, error) {
-	out := new(CreateProviderResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+func (c *reservationServiceProtobufClient) callExtendHold(ctx context.Context, in *ExtendHoldRequest) (*ExtendHoldResponse, error) {
+	out := new(ExtendHoldResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -762,26 +839,26 @@ func (c *reservationServiceJSONClient) callCreateProvider(ctx context.Context, i
 	return out, nil
 }
 
-func (c *reservationServiceJSONClient) GetProvider(ctx context.Context, in *GetProviderRequest) (*GetProviderResponse, error) {
+func (c *reservationServiceProtobufClient) CancelReservation(ctx context.Context, in *CancelReservationRequest) (*CancelReservationResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "reservation")
 	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
-	ctx = ctxsetters.WithMethodName(ctx, "GetProvider")
-	caller := c.callGetProvider
+	ctx = ctxsetters.WithMethodName(ctx, "CancelReservation")
+	caller := c.callCancelReservation
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *GetProviderRequest) (*GetProviderResponse, error) {
+		caller = func(ctx context.Context, req *CancelReservationRequest) (*CancelReservationResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetProviderRequest)
+					typedReq, ok := req.(*CancelReservationRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetProviderRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*CancelReservationRequest) when calling interceptor")
 					}
-					return c.callGetProvider(ctx, typedReq)
+					return c.callCancelReservation(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetProviderResponse)
+				typedResp, ok := resp.(*CancelReservationResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetProviderResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*CancelReservationResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -791,9 +868,9 @@ func (c *reservationServiceJSONClient) GetProvider(ctx context.Context, in *GetP
 	return caller(ctx, in)
 }
 
-func (c *reservationServiceJSONClient) callGetProvider(ctx context.Context, in *GetProviderRequest) (*GetProviderResponse, error) {
-	out := new(GetProviderResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+func (c *reservationServiceProtobufClient) callCancelReservation(ctx context.Context, in *CancelReservationRequest) (*CancelReservationResponse, error) {
+	out := new(CancelReservationResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -808,26 +885,26 @@ func (c *reservationServiceJSONClient) callGetProvider(ctx context.Context, in *
 	return out, nil
 }
 
-func (c *reservationServiceJSONClient) GetReservedSlotsByProvider(ctx context.Context, in *GetReservedSlotsByProviderRequest) (*GetReservedSlotsByProviderResponse, error) {
+func (c *reservationServiceProtobufClient) RescheduleReservation(ctx context.Context, in *RescheduleReservationRequest) (*RescheduleReservationResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "reservation")
 	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
-	ctx = ctxsetters.WithMethodName(ctx, "GetReservedSlotsByProvider")
-	caller := c.callGetReservedSlotsByProvider
+	ctx = ctxsetters.WithMethodName(ctx, "RescheduleReservation")
+	caller := c.callRescheduleReservation
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *GetReservedSlotsByProviderRequest) (*GetReservedSlotsByProviderResponse, error) {
+		caller = func(ctx context.Context, req *RescheduleReservationRequest) (*RescheduleReservationResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetReservedSlotsByProviderRequest)
+					typedReq, ok := req.(*RescheduleReservationRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetReservedSlotsByProviderRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RescheduleReservationRequest) when calling interceptor")
 					}
-					return c.callGetReservedSlotsByProvider(ctx, typedReq)
+					return c.callRescheduleReservation(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetReservedSlotsByProviderResponse)
+				typedResp, ok := resp.(*RescheduleReservationResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetReservedSlotsByProviderResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*RescheduleReservationResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -837,9 +914,9 @@ func (c *reservationServiceJSONClient) GetReservedSlotsByProvider(ctx context.Co
 	return caller(ctx, in)
 }
 
-func (c *reservationServiceJSONClient) callGetReservedSlotsByProvider(ctx context.Context, in *GetReservedSlotsByProviderRequest) (*GetReservedSlotsByProviderResponse, error) {
-	out := new(GetReservedSlotsByProviderResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+func (c *reservationServiceProtobufClient) callRescheduleReservation(ctx context.Context, in *RescheduleReservationRequest) (*RescheduleReservationResponse, error) {
+	out := new(RescheduleReservationResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -854,26 +931,26 @@ func (c *reservationServiceJSONClient) callGetReservedSlotsByProvider(ctx contex
 	return out, nil
 }
 
-func (c *reservationServiceJSONClient) GetReservedSlotsByClient(ctx context.Context, in *GetReservedSlotsByClientRequest) (*GetReservedSlotsByClientResponse, error) {
+func (c *reservationServiceProtobufClient) CreateProvider(ctx context.Context, in *CreateProviderRequest) (*CreateProviderResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "reservation")
 	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
-	ctx = ctxsetters.WithMethodName(ctx, "GetReservedSlotsByClient")
-	caller := c.callGetReservedSlotsByClient
+	ctx = ctxsetters.WithMethodName(ctx, "CreateProvider")
+	caller := c.callCreateProvider
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *GetReservedSlotsByClientRequest) (*GetReservedSlotsByClientResponse, error) {
+		caller = func(ctx context.Context, req *CreateProviderRequest) (*CreateProviderResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetReservedSlotsByClientRequest)
+					typedReq, ok := req.(*CreateProviderRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetReservedSlotsByClientRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*CreateProviderRequest) when calling interceptor")
 					}
-					return c.callGetReservedSlotsByClient(ctx, typedReq)
+					return c.callCreateProvider(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetReservedSlotsByClientResponse)
+				typedResp, ok := resp.(*CreateProviderResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetReservedSlotsByClientResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateProviderResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -883,9 +960,9 @@ func (c *reservationServiceJSONClient) GetReservedSlotsByClient(ctx context.Cont
 	return caller(ctx, in)
 }
 
-func (c *reservationServiceJSONClient) callGetReservedSlotsByClient(ctx context.Context, in *GetReservedSlotsByClientRequest) (*GetReservedSlotsByClientResponse, error) {
-	out := new(GetReservedSlotsByClientResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+func (c *reservationServiceProtobufClient) callCreateProvider(ctx context.Context, in *CreateProviderRequest) (*CreateProviderResponse, error) {
+	out := new(CreateProviderResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -900,135 +977,5938 @@ func (c *reservationServiceJSONClient) callGetReservedSlotsByClient(ctx context.
 	return out, nil
 }
 
-// =================================
+func (c *reservationServiceProtobufClient) GetProvider(ctx context.Context, in *GetProviderRequest) (*GetProviderResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetProvider")
+	caller := c.callGetProvider
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetProviderRequest) (*GetProviderResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetProviderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetProviderRequest) when calling interceptor")
+					}
+					return c.callGetProvider(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetProviderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetProviderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceProtobufClient) callGetProvider(ctx context.Context, in *GetProviderRequest) (*GetProviderResponse, error) {
+	out := new(GetProviderResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[17], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceProtobufClient) UpdateProvider(ctx context.Context, in *UpdateProviderRequest) (*UpdateProviderResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateProvider")
+	caller := c.callUpdateProvider
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateProviderRequest) (*UpdateProviderResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateProviderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateProviderRequest) when calling interceptor")
+					}
+					return c.callUpdateProvider(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateProviderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateProviderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceProtobufClient) callUpdateProvider(ctx context.Context, in *UpdateProviderRequest) (*UpdateProviderResponse, error) {
+	out := new(UpdateProviderResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[18], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceProtobufClient) DeactivateProvider(ctx context.Context, in *DeactivateProviderRequest) (*DeactivateProviderResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeactivateProvider")
+	caller := c.callDeactivateProvider
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeactivateProviderRequest) (*DeactivateProviderResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeactivateProviderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeactivateProviderRequest) when calling interceptor")
+					}
+					return c.callDeactivateProvider(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeactivateProviderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeactivateProviderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceProtobufClient) callDeactivateProvider(ctx context.Context, in *DeactivateProviderRequest) (*DeactivateProviderResponse, error) {
+	out := new(DeactivateProviderResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[19], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceProtobufClient) ListProviders(ctx context.Context, in *ListProvidersRequest) (*ListProvidersResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListProviders")
+	caller := c.callListProviders
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListProvidersRequest) (*ListProvidersResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListProvidersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListProvidersRequest) when calling interceptor")
+					}
+					return c.callListProviders(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListProvidersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListProvidersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceProtobufClient) callListProviders(ctx context.Context, in *ListProvidersRequest) (*ListProvidersResponse, error) {
+	out := new(ListProvidersResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[20], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceProtobufClient) CreateClient(ctx context.Context, in *CreateClientRequest) (*CreateClientResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateClient")
+	caller := c.callCreateClient
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateClientRequest) (*CreateClientResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateClientRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateClientRequest) when calling interceptor")
+					}
+					return c.callCreateClient(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateClientResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateClientResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceProtobufClient) callCreateClient(ctx context.Context, in *CreateClientRequest) (*CreateClientResponse, error) {
+	out := new(CreateClientResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[21], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceProtobufClient) GetClient(ctx context.Context, in *GetClientRequest) (*GetClientResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetClient")
+	caller := c.callGetClient
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetClientRequest) (*GetClientResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetClientRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetClientRequest) when calling interceptor")
+					}
+					return c.callGetClient(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetClientResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetClientResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceProtobufClient) callGetClient(ctx context.Context, in *GetClientRequest) (*GetClientResponse, error) {
+	out := new(GetClientResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[22], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceProtobufClient) UpdateClient(ctx context.Context, in *UpdateClientRequest) (*UpdateClientResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateClient")
+	caller := c.callUpdateClient
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateClientRequest) (*UpdateClientResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateClientRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateClientRequest) when calling interceptor")
+					}
+					return c.callUpdateClient(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateClientResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateClientResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceProtobufClient) callUpdateClient(ctx context.Context, in *UpdateClientRequest) (*UpdateClientResponse, error) {
+	out := new(UpdateClientResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[23], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceProtobufClient) GetReservedSlotsByProvider(ctx context.Context, in *GetReservedSlotsByProviderRequest) (*GetReservedSlotsByProviderResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetReservedSlotsByProvider")
+	caller := c.callGetReservedSlotsByProvider
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetReservedSlotsByProviderRequest) (*GetReservedSlotsByProviderResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetReservedSlotsByProviderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetReservedSlotsByProviderRequest) when calling interceptor")
+					}
+					return c.callGetReservedSlotsByProvider(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetReservedSlotsByProviderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetReservedSlotsByProviderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceProtobufClient) callGetReservedSlotsByProvider(ctx context.Context, in *GetReservedSlotsByProviderRequest) (*GetReservedSlotsByProviderResponse, error) {
+	out := new(GetReservedSlotsByProviderResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[24], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceProtobufClient) GetReservedSlotsByClient(ctx context.Context, in *GetReservedSlotsByClientRequest) (*GetReservedSlotsByClientResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetReservedSlotsByClient")
+	caller := c.callGetReservedSlotsByClient
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetReservedSlotsByClientRequest) (*GetReservedSlotsByClientResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetReservedSlotsByClientRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetReservedSlotsByClientRequest) when calling interceptor")
+					}
+					return c.callGetReservedSlotsByClient(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetReservedSlotsByClientResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetReservedSlotsByClientResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceProtobufClient) callGetReservedSlotsByClient(ctx context.Context, in *GetReservedSlotsByClientRequest) (*GetReservedSlotsByClientResponse, error) {
+	out := new(GetReservedSlotsByClientResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[25], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceProtobufClient) JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest) (*JoinWaitlistResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "JoinWaitlist")
+	caller := c.callJoinWaitlist
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *JoinWaitlistRequest) (*JoinWaitlistResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*JoinWaitlistRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*JoinWaitlistRequest) when calling interceptor")
+					}
+					return c.callJoinWaitlist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*JoinWaitlistResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*JoinWaitlistResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceProtobufClient) callJoinWaitlist(ctx context.Context, in *JoinWaitlistRequest) (*JoinWaitlistResponse, error) {
+	out := new(JoinWaitlistResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[26], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceProtobufClient) GetWaitlistByClient(ctx context.Context, in *GetWaitlistByClientRequest) (*GetWaitlistByClientResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetWaitlistByClient")
+	caller := c.callGetWaitlistByClient
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetWaitlistByClientRequest) (*GetWaitlistByClientResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetWaitlistByClientRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetWaitlistByClientRequest) when calling interceptor")
+					}
+					return c.callGetWaitlistByClient(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetWaitlistByClientResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetWaitlistByClientResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceProtobufClient) callGetWaitlistByClient(ctx context.Context, in *GetWaitlistByClientRequest) (*GetWaitlistByClientResponse, error) {
+	out := new(GetWaitlistByClientResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[27], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceProtobufClient) AcceptWaitlistOffer(ctx context.Context, in *AcceptWaitlistOfferRequest) (*AcceptWaitlistOfferResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "AcceptWaitlistOffer")
+	caller := c.callAcceptWaitlistOffer
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AcceptWaitlistOfferRequest) (*AcceptWaitlistOfferResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AcceptWaitlistOfferRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AcceptWaitlistOfferRequest) when calling interceptor")
+					}
+					return c.callAcceptWaitlistOffer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AcceptWaitlistOfferResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AcceptWaitlistOfferResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceProtobufClient) callAcceptWaitlistOffer(ctx context.Context, in *AcceptWaitlistOfferRequest) (*AcceptWaitlistOfferResponse, error) {
+	out := new(AcceptWaitlistOfferResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[28], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ==============================
+// ReservationService JSON Client
+// ==============================
+
+type reservationServiceJSONClient struct {
+	client      HTTPClient
+	urls        [29]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewReservationServiceJSONClient creates a JSON client that implements the ReservationService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewReservationServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) ReservationService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "reservation", "ReservationService")
+	urls := [29]string{
+		serviceURL + "SetAvailability",
+		serviceURL + "UpdateAvailability",
+		serviceURL + "RemoveAvailability",
+		serviceURL + "CreateAvailabilityRule",
+		serviceURL + "ListAvailabilityRules",
+		serviceURL + "DeleteAvailabilityRule",
+		serviceURL + "AddAvailabilityRuleException",
+		serviceURL + "CreateAppointmentType",
+		serviceURL + "ListAppointmentTypes",
+		serviceURL + "DeleteAppointmentType",
+		serviceURL + "GetAvailableSlots",
+		serviceURL + "ReserveSlot",
+		serviceURL + "ConfirmReservation",
+		serviceURL + "ExtendHold",
+		serviceURL + "CancelReservation",
+		serviceURL + "RescheduleReservation",
+		serviceURL + "CreateProvider",
+		serviceURL + "GetProvider",
+		serviceURL + "UpdateProvider",
+		serviceURL + "DeactivateProvider",
+		serviceURL + "ListProviders",
+		serviceURL + "CreateClient",
+		serviceURL + "GetClient",
+		serviceURL + "UpdateClient",
+		serviceURL + "GetReservedSlotsByProvider",
+		serviceURL + "GetReservedSlotsByClient",
+		serviceURL + "JoinWaitlist",
+		serviceURL + "GetWaitlistByClient",
+		serviceURL + "AcceptWaitlistOffer",
+	}
+
+	return &reservationServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *reservationServiceJSONClient) SetAvailability(ctx context.Context, in *SetAvailabilityRequest) (*SetAvailabilityResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "SetAvailability")
+	caller := c.callSetAvailability
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetAvailabilityRequest) (*SetAvailabilityResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetAvailabilityRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetAvailabilityRequest) when calling interceptor")
+					}
+					return c.callSetAvailability(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetAvailabilityResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetAvailabilityResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callSetAvailability(ctx context.Context, in *SetAvailabilityRequest) (*SetAvailabilityResponse, error) {
+	out := new(SetAvailabilityResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceJSONClient) UpdateAvailability(ctx context.Context, in *UpdateAvailabilityRequest) (*UpdateAvailabilityResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateAvailability")
+	caller := c.callUpdateAvailability
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateAvailabilityRequest) (*UpdateAvailabilityResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateAvailabilityRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateAvailabilityRequest) when calling interceptor")
+					}
+					return c.callUpdateAvailability(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateAvailabilityResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateAvailabilityResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callUpdateAvailability(ctx context.Context, in *UpdateAvailabilityRequest) (*UpdateAvailabilityResponse, error) {
+	out := new(UpdateAvailabilityResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceJSONClient) RemoveAvailability(ctx context.Context, in *RemoveAvailabilityRequest) (*RemoveAvailabilityResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveAvailability")
+	caller := c.callRemoveAvailability
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RemoveAvailabilityRequest) (*RemoveAvailabilityResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RemoveAvailabilityRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RemoveAvailabilityRequest) when calling interceptor")
+					}
+					return c.callRemoveAvailability(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RemoveAvailabilityResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RemoveAvailabilityResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callRemoveAvailability(ctx context.Context, in *RemoveAvailabilityRequest) (*RemoveAvailabilityResponse, error) {
+	out := new(RemoveAvailabilityResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceJSONClient) CreateAvailabilityRule(ctx context.Context, in *CreateAvailabilityRuleRequest) (*CreateAvailabilityRuleResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateAvailabilityRule")
+	caller := c.callCreateAvailabilityRule
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateAvailabilityRuleRequest) (*CreateAvailabilityRuleResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateAvailabilityRuleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateAvailabilityRuleRequest) when calling interceptor")
+					}
+					return c.callCreateAvailabilityRule(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateAvailabilityRuleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateAvailabilityRuleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callCreateAvailabilityRule(ctx context.Context, in *CreateAvailabilityRuleRequest) (*CreateAvailabilityRuleResponse, error) {
+	out := new(CreateAvailabilityRuleResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceJSONClient) ListAvailabilityRules(ctx context.Context, in *ListAvailabilityRulesRequest) (*ListAvailabilityRulesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListAvailabilityRules")
+	caller := c.callListAvailabilityRules
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListAvailabilityRulesRequest) (*ListAvailabilityRulesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListAvailabilityRulesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListAvailabilityRulesRequest) when calling interceptor")
+					}
+					return c.callListAvailabilityRules(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListAvailabilityRulesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListAvailabilityRulesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callListAvailabilityRules(ctx context.Context, in *ListAvailabilityRulesRequest) (*ListAvailabilityRulesResponse, error) {
+	out := new(ListAvailabilityRulesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceJSONClient) DeleteAvailabilityRule(ctx context.Context, in *DeleteAvailabilityRuleRequest) (*DeleteAvailabilityRuleResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteAvailabilityRule")
+	caller := c.callDeleteAvailabilityRule
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteAvailabilityRuleRequest) (*DeleteAvailabilityRuleResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteAvailabilityRuleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteAvailabilityRuleRequest) when calling interceptor")
+					}
+					return c.callDeleteAvailabilityRule(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteAvailabilityRuleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteAvailabilityRuleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callDeleteAvailabilityRule(ctx context.Context, in *DeleteAvailabilityRuleRequest) (*DeleteAvailabilityRuleResponse, error) {
+	out := new(DeleteAvailabilityRuleResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceJSONClient) AddAvailabilityRuleException(ctx context.Context, in *AddAvailabilityRuleExceptionRequest) (*AddAvailabilityRuleExceptionResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "AddAvailabilityRuleException")
+	caller := c.callAddAvailabilityRuleException
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AddAvailabilityRuleExceptionRequest) (*AddAvailabilityRuleExceptionResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddAvailabilityRuleExceptionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddAvailabilityRuleExceptionRequest) when calling interceptor")
+					}
+					return c.callAddAvailabilityRuleException(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AddAvailabilityRuleExceptionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AddAvailabilityRuleExceptionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callAddAvailabilityRuleException(ctx context.Context, in *AddAvailabilityRuleExceptionRequest) (*AddAvailabilityRuleExceptionResponse, error) {
+	out := new(AddAvailabilityRuleExceptionResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceJSONClient) CreateAppointmentType(ctx context.Context, in *CreateAppointmentTypeRequest) (*CreateAppointmentTypeResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateAppointmentType")
+	caller := c.callCreateAppointmentType
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateAppointmentTypeRequest) (*CreateAppointmentTypeResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateAppointmentTypeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateAppointmentTypeRequest) when calling interceptor")
+					}
+					return c.callCreateAppointmentType(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateAppointmentTypeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateAppointmentTypeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callCreateAppointmentType(ctx context.Context, in *CreateAppointmentTypeRequest) (*CreateAppointmentTypeResponse, error) {
+	out := new(CreateAppointmentTypeResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceJSONClient) ListAppointmentTypes(ctx context.Context, in *ListAppointmentTypesRequest) (*ListAppointmentTypesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListAppointmentTypes")
+	caller := c.callListAppointmentTypes
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListAppointmentTypesRequest) (*ListAppointmentTypesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListAppointmentTypesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListAppointmentTypesRequest) when calling interceptor")
+					}
+					return c.callListAppointmentTypes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListAppointmentTypesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListAppointmentTypesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callListAppointmentTypes(ctx context.Context, in *ListAppointmentTypesRequest) (*ListAppointmentTypesResponse, error) {
+	out := new(ListAppointmentTypesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceJSONClient) DeleteAppointmentType(ctx context.Context, in *DeleteAppointmentTypeRequest) (*DeleteAppointmentTypeResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteAppointmentType")
+	caller := c.callDeleteAppointmentType
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteAppointmentTypeRequest) (*DeleteAppointmentTypeResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteAppointmentTypeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteAppointmentTypeRequest) when calling interceptor")
+					}
+					return c.callDeleteAppointmentType(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteAppointmentTypeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteAppointmentTypeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callDeleteAppointmentType(ctx context.Context, in *DeleteAppointmentTypeRequest) (*DeleteAppointmentTypeResponse, error) {
+	out := new(DeleteAppointmentTypeResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceJSONClient) GetAvailableSlots(ctx context.Context, in *GetAvailableSlotsRequest) (*GetAvailableSlotsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetAvailableSlots")
+	caller := c.callGetAvailableSlots
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetAvailableSlotsRequest) (*GetAvailableSlotsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetAvailableSlotsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetAvailableSlotsRequest) when calling interceptor")
+					}
+					return c.callGetAvailableSlots(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetAvailableSlotsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetAvailableSlotsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callGetAvailableSlots(ctx context.Context, in *GetAvailableSlotsRequest) (*GetAvailableSlotsResponse, error) {
+	out := new(GetAvailableSlotsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceJSONClient) ReserveSlot(ctx context.Context, in *ReserveSlotRequest) (*ReserveSlotResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "ReserveSlot")
+	caller := c.callReserveSlot
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ReserveSlotRequest) (*ReserveSlotResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReserveSlotRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReserveSlotRequest) when calling interceptor")
+					}
+					return c.callReserveSlot(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReserveSlotResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReserveSlotResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callReserveSlot(ctx context.Context, in *ReserveSlotRequest) (*ReserveSlotResponse, error) {
+	out := new(ReserveSlotResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceJSONClient) ConfirmReservation(ctx context.Context, in *ConfirmReservationRequest) (*ConfirmReservationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "ConfirmReservation")
+	caller := c.callConfirmReservation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ConfirmReservationRequest) (*ConfirmReservationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ConfirmReservationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ConfirmReservationRequest) when calling interceptor")
+					}
+					return c.callConfirmReservation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ConfirmReservationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ConfirmReservationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callConfirmReservation(ctx context.Context, in *ConfirmReservationRequest) (*ConfirmReservationResponse, error) {
+	out := new(ConfirmReservationResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceJSONClient) ExtendHold(ctx context.Context, in *ExtendHoldRequest) (*ExtendHoldResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "ExtendHold")
+	caller := c.callExtendHold
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ExtendHoldRequest) (*ExtendHoldResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ExtendHoldRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ExtendHoldRequest) when calling interceptor")
+					}
+					return c.callExtendHold(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ExtendHoldResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ExtendHoldResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callExtendHold(ctx context.Context, in *ExtendHoldRequest) (*ExtendHoldResponse, error) {
+	out := new(ExtendHoldResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceJSONClient) CancelReservation(ctx context.Context, in *CancelReservationRequest) (*CancelReservationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "CancelReservation")
+	caller := c.callCancelReservation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CancelReservationRequest) (*CancelReservationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CancelReservationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CancelReservationRequest) when calling interceptor")
+					}
+					return c.callCancelReservation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CancelReservationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CancelReservationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callCancelReservation(ctx context.Context, in *CancelReservationRequest) (*CancelReservationResponse, error) {
+	out := new(CancelReservationResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceJSONClient) RescheduleReservation(ctx context.Context, in *RescheduleReservationRequest) (*RescheduleReservationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "RescheduleReservation")
+	caller := c.callRescheduleReservation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RescheduleReservationRequest) (*RescheduleReservationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RescheduleReservationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RescheduleReservationRequest) when calling interceptor")
+					}
+					return c.callRescheduleReservation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RescheduleReservationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RescheduleReservationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callRescheduleReservation(ctx context.Context, in *RescheduleReservationRequest) (*RescheduleReservationResponse, error) {
+	out := new(RescheduleReservationResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceJSONClient) CreateProvider(ctx context.Context, in *CreateProviderRequest) (*CreateProviderResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateProvider")
+	caller := c.callCreateProvider
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateProviderRequest) (*CreateProviderResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateProviderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateProviderRequest) when calling interceptor")
+					}
+					return c.callCreateProvider(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateProviderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateProviderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callCreateProvider(ctx context.Context, in *CreateProviderRequest) (*CreateProviderResponse, error) {
+	out := new(CreateProviderResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceJSONClient) GetProvider(ctx context.Context, in *GetProviderRequest) (*GetProviderResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetProvider")
+	caller := c.callGetProvider
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetProviderRequest) (*GetProviderResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetProviderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetProviderRequest) when calling interceptor")
+					}
+					return c.callGetProvider(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetProviderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetProviderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callGetProvider(ctx context.Context, in *GetProviderRequest) (*GetProviderResponse, error) {
+	out := new(GetProviderResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[17], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceJSONClient) UpdateProvider(ctx context.Context, in *UpdateProviderRequest) (*UpdateProviderResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateProvider")
+	caller := c.callUpdateProvider
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateProviderRequest) (*UpdateProviderResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateProviderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateProviderRequest) when calling interceptor")
+					}
+					return c.callUpdateProvider(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateProviderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateProviderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callUpdateProvider(ctx context.Context, in *UpdateProviderRequest) (*UpdateProviderResponse, error) {
+	out := new(UpdateProviderResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[18], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceJSONClient) DeactivateProvider(ctx context.Context, in *DeactivateProviderRequest) (*DeactivateProviderResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeactivateProvider")
+	caller := c.callDeactivateProvider
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeactivateProviderRequest) (*DeactivateProviderResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeactivateProviderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeactivateProviderRequest) when calling interceptor")
+					}
+					return c.callDeactivateProvider(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeactivateProviderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeactivateProviderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callDeactivateProvider(ctx context.Context, in *DeactivateProviderRequest) (*DeactivateProviderResponse, error) {
+	out := new(DeactivateProviderResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[19], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceJSONClient) ListProviders(ctx context.Context, in *ListProvidersRequest) (*ListProvidersResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListProviders")
+	caller := c.callListProviders
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListProvidersRequest) (*ListProvidersResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListProvidersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListProvidersRequest) when calling interceptor")
+					}
+					return c.callListProviders(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListProvidersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListProvidersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callListProviders(ctx context.Context, in *ListProvidersRequest) (*ListProvidersResponse, error) {
+	out := new(ListProvidersResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[20], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceJSONClient) CreateClient(ctx context.Context, in *CreateClientRequest) (*CreateClientResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateClient")
+	caller := c.callCreateClient
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateClientRequest) (*CreateClientResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateClientRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateClientRequest) when calling interceptor")
+					}
+					return c.callCreateClient(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateClientResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateClientResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callCreateClient(ctx context.Context, in *CreateClientRequest) (*CreateClientResponse, error) {
+	out := new(CreateClientResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[21], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceJSONClient) GetClient(ctx context.Context, in *GetClientRequest) (*GetClientResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetClient")
+	caller := c.callGetClient
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetClientRequest) (*GetClientResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetClientRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetClientRequest) when calling interceptor")
+					}
+					return c.callGetClient(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetClientResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetClientResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callGetClient(ctx context.Context, in *GetClientRequest) (*GetClientResponse, error) {
+	out := new(GetClientResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[22], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceJSONClient) UpdateClient(ctx context.Context, in *UpdateClientRequest) (*UpdateClientResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateClient")
+	caller := c.callUpdateClient
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateClientRequest) (*UpdateClientResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateClientRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateClientRequest) when calling interceptor")
+					}
+					return c.callUpdateClient(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateClientResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateClientResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callUpdateClient(ctx context.Context, in *UpdateClientRequest) (*UpdateClientResponse, error) {
+	out := new(UpdateClientResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[23], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceJSONClient) GetReservedSlotsByProvider(ctx context.Context, in *GetReservedSlotsByProviderRequest) (*GetReservedSlotsByProviderResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetReservedSlotsByProvider")
+	caller := c.callGetReservedSlotsByProvider
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetReservedSlotsByProviderRequest) (*GetReservedSlotsByProviderResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetReservedSlotsByProviderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetReservedSlotsByProviderRequest) when calling interceptor")
+					}
+					return c.callGetReservedSlotsByProvider(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetReservedSlotsByProviderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetReservedSlotsByProviderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callGetReservedSlotsByProvider(ctx context.Context, in *GetReservedSlotsByProviderRequest) (*GetReservedSlotsByProviderResponse, error) {
+	out := new(GetReservedSlotsByProviderResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[24], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceJSONClient) GetReservedSlotsByClient(ctx context.Context, in *GetReservedSlotsByClientRequest) (*GetReservedSlotsByClientResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetReservedSlotsByClient")
+	caller := c.callGetReservedSlotsByClient
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetReservedSlotsByClientRequest) (*GetReservedSlotsByClientResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetReservedSlotsByClientRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetReservedSlotsByClientRequest) when calling interceptor")
+					}
+					return c.callGetReservedSlotsByClient(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetReservedSlotsByClientResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetReservedSlotsByClientResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callGetReservedSlotsByClient(ctx context.Context, in *GetReservedSlotsByClientRequest) (*GetReservedSlotsByClientResponse, error) {
+	out := new(GetReservedSlotsByClientResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[25], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceJSONClient) JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest) (*JoinWaitlistResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "JoinWaitlist")
+	caller := c.callJoinWaitlist
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *JoinWaitlistRequest) (*JoinWaitlistResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*JoinWaitlistRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*JoinWaitlistRequest) when calling interceptor")
+					}
+					return c.callJoinWaitlist(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*JoinWaitlistResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*JoinWaitlistResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callJoinWaitlist(ctx context.Context, in *JoinWaitlistRequest) (*JoinWaitlistResponse, error) {
+	out := new(JoinWaitlistResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[26], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceJSONClient) GetWaitlistByClient(ctx context.Context, in *GetWaitlistByClientRequest) (*GetWaitlistByClientResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetWaitlistByClient")
+	caller := c.callGetWaitlistByClient
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetWaitlistByClientRequest) (*GetWaitlistByClientResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetWaitlistByClientRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetWaitlistByClientRequest) when calling interceptor")
+					}
+					return c.callGetWaitlistByClient(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetWaitlistByClientResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetWaitlistByClientResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callGetWaitlistByClient(ctx context.Context, in *GetWaitlistByClientRequest) (*GetWaitlistByClientResponse, error) {
+	out := new(GetWaitlistByClientResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[27], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *reservationServiceJSONClient) AcceptWaitlistOffer(ctx context.Context, in *AcceptWaitlistOfferRequest) (*AcceptWaitlistOfferResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithMethodName(ctx, "AcceptWaitlistOffer")
+	caller := c.callAcceptWaitlistOffer
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AcceptWaitlistOfferRequest) (*AcceptWaitlistOfferResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AcceptWaitlistOfferRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AcceptWaitlistOfferRequest) when calling interceptor")
+					}
+					return c.callAcceptWaitlistOffer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AcceptWaitlistOfferResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AcceptWaitlistOfferResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reservationServiceJSONClient) callAcceptWaitlistOffer(ctx context.Context, in *AcceptWaitlistOfferRequest) (*AcceptWaitlistOfferResponse, error) {
+	out := new(AcceptWaitlistOfferResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[28], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =================================
 // ReservationService Server Handler
 // =================================
 
-type reservationServiceServer struct {
-	ReservationService
-	interceptor      twirp.Interceptor
-	hooks            *twirp.ServerHooks
-	pathPrefix       string // prefix for routing
-	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
-	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
-}
+type reservationServiceServer struct {
+	ReservationService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewReservationServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewReservationServiceServer(svc ReservationService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &reservationServiceServer{
+		ReservationService: svc,
+		hooks:              serverOpts.Hooks,
+		interceptor:        twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:         pathPrefix,
+		jsonSkipDefaults:   jsonSkipDefaults,
+		jsonCamelCase:      jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *reservationServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *reservationServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// ReservationServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const ReservationServicePathPrefix = "/twirp/reservation.ReservationService/"
+
+func (s *reservationServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "reservation")
+	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "reservation.ReservationService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "SetAvailability":
+		s.serveSetAvailability(ctx, resp, req)
+		return
+	case "UpdateAvailability":
+		s.serveUpdateAvailability(ctx, resp, req)
+		return
+	case "RemoveAvailability":
+		s.serveRemoveAvailability(ctx, resp, req)
+		return
+	case "CreateAvailabilityRule":
+		s.serveCreateAvailabilityRule(ctx, resp, req)
+		return
+	case "ListAvailabilityRules":
+		s.serveListAvailabilityRules(ctx, resp, req)
+		return
+	case "DeleteAvailabilityRule":
+		s.serveDeleteAvailabilityRule(ctx, resp, req)
+		return
+	case "AddAvailabilityRuleException":
+		s.serveAddAvailabilityRuleException(ctx, resp, req)
+		return
+	case "CreateAppointmentType":
+		s.serveCreateAppointmentType(ctx, resp, req)
+		return
+	case "ListAppointmentTypes":
+		s.serveListAppointmentTypes(ctx, resp, req)
+		return
+	case "DeleteAppointmentType":
+		s.serveDeleteAppointmentType(ctx, resp, req)
+		return
+	case "GetAvailableSlots":
+		s.serveGetAvailableSlots(ctx, resp, req)
+		return
+	case "ReserveSlot":
+		s.serveReserveSlot(ctx, resp, req)
+		return
+	case "ConfirmReservation":
+		s.serveConfirmReservation(ctx, resp, req)
+		return
+	case "ExtendHold":
+		s.serveExtendHold(ctx, resp, req)
+		return
+	case "CancelReservation":
+		s.serveCancelReservation(ctx, resp, req)
+		return
+	case "RescheduleReservation":
+		s.serveRescheduleReservation(ctx, resp, req)
+		return
+	case "CreateProvider":
+		s.serveCreateProvider(ctx, resp, req)
+		return
+	case "GetProvider":
+		s.serveGetProvider(ctx, resp, req)
+		return
+	case "UpdateProvider":
+		s.serveUpdateProvider(ctx, resp, req)
+		return
+	case "DeactivateProvider":
+		s.serveDeactivateProvider(ctx, resp, req)
+		return
+	case "ListProviders":
+		s.serveListProviders(ctx, resp, req)
+		return
+	case "CreateClient":
+		s.serveCreateClient(ctx, resp, req)
+		return
+	case "GetClient":
+		s.serveGetClient(ctx, resp, req)
+		return
+	case "UpdateClient":
+		s.serveUpdateClient(ctx, resp, req)
+		return
+	case "GetReservedSlotsByProvider":
+		s.serveGetReservedSlotsByProvider(ctx, resp, req)
+		return
+	case "GetReservedSlotsByClient":
+		s.serveGetReservedSlotsByClient(ctx, resp, req)
+		return
+	case "JoinWaitlist":
+		s.serveJoinWaitlist(ctx, resp, req)
+		return
+	case "GetWaitlistByClient":
+		s.serveGetWaitlistByClient(ctx, resp, req)
+		return
+	case "AcceptWaitlistOffer":
+		s.serveAcceptWaitlistOffer(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *reservationServiceServer) serveSetAvailability(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSetAvailabilityJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSetAvailabilityProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *reservationServiceServer) serveSetAvailabilityJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetAvailability")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SetAvailabilityRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ReservationService.SetAvailability
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetAvailabilityRequest) (*SetAvailabilityResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetAvailabilityRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetAvailabilityRequest) when calling interceptor")
+					}
+					return s.ReservationService.SetAvailability(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetAvailabilityResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetAvailabilityResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SetAvailabilityResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SetAvailabilityResponse and nil error while calling SetAvailability. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveSetAvailabilityProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetAvailability")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SetAvailabilityRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ReservationService.SetAvailability
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetAvailabilityRequest) (*SetAvailabilityResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetAvailabilityRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetAvailabilityRequest) when calling interceptor")
+					}
+					return s.ReservationService.SetAvailability(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetAvailabilityResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetAvailabilityResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SetAvailabilityResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SetAvailabilityResponse and nil error while calling SetAvailability. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveUpdateAvailability(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdateAvailabilityJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdateAvailabilityProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *reservationServiceServer) serveUpdateAvailabilityJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateAvailability")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UpdateAvailabilityRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ReservationService.UpdateAvailability
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateAvailabilityRequest) (*UpdateAvailabilityResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateAvailabilityRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateAvailabilityRequest) when calling interceptor")
+					}
+					return s.ReservationService.UpdateAvailability(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateAvailabilityResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateAvailabilityResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateAvailabilityResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateAvailabilityResponse and nil error while calling UpdateAvailability. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveUpdateAvailabilityProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateAvailability")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UpdateAvailabilityRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ReservationService.UpdateAvailability
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateAvailabilityRequest) (*UpdateAvailabilityResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateAvailabilityRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateAvailabilityRequest) when calling interceptor")
+					}
+					return s.ReservationService.UpdateAvailability(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateAvailabilityResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateAvailabilityResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateAvailabilityResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateAvailabilityResponse and nil error while calling UpdateAvailability. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveRemoveAvailability(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRemoveAvailabilityJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRemoveAvailabilityProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *reservationServiceServer) serveRemoveAvailabilityJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveAvailability")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RemoveAvailabilityRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ReservationService.RemoveAvailability
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RemoveAvailabilityRequest) (*RemoveAvailabilityResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RemoveAvailabilityRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RemoveAvailabilityRequest) when calling interceptor")
+					}
+					return s.ReservationService.RemoveAvailability(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RemoveAvailabilityResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RemoveAvailabilityResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RemoveAvailabilityResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RemoveAvailabilityResponse and nil error while calling RemoveAvailability. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveRemoveAvailabilityProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RemoveAvailability")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RemoveAvailabilityRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ReservationService.RemoveAvailability
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RemoveAvailabilityRequest) (*RemoveAvailabilityResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RemoveAvailabilityRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RemoveAvailabilityRequest) when calling interceptor")
+					}
+					return s.ReservationService.RemoveAvailability(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RemoveAvailabilityResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RemoveAvailabilityResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RemoveAvailabilityResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RemoveAvailabilityResponse and nil error while calling RemoveAvailability. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveCreateAvailabilityRule(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateAvailabilityRuleJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateAvailabilityRuleProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *reservationServiceServer) serveCreateAvailabilityRuleJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateAvailabilityRule")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreateAvailabilityRuleRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ReservationService.CreateAvailabilityRule
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateAvailabilityRuleRequest) (*CreateAvailabilityRuleResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateAvailabilityRuleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateAvailabilityRuleRequest) when calling interceptor")
+					}
+					return s.ReservationService.CreateAvailabilityRule(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateAvailabilityRuleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateAvailabilityRuleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateAvailabilityRuleResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateAvailabilityRuleResponse and nil error while calling CreateAvailabilityRule. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveCreateAvailabilityRuleProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateAvailabilityRule")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CreateAvailabilityRuleRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ReservationService.CreateAvailabilityRule
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateAvailabilityRuleRequest) (*CreateAvailabilityRuleResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateAvailabilityRuleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateAvailabilityRuleRequest) when calling interceptor")
+					}
+					return s.ReservationService.CreateAvailabilityRule(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateAvailabilityRuleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateAvailabilityRuleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateAvailabilityRuleResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateAvailabilityRuleResponse and nil error while calling CreateAvailabilityRule. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveListAvailabilityRules(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListAvailabilityRulesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListAvailabilityRulesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *reservationServiceServer) serveListAvailabilityRulesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListAvailabilityRules")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListAvailabilityRulesRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ReservationService.ListAvailabilityRules
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListAvailabilityRulesRequest) (*ListAvailabilityRulesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListAvailabilityRulesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListAvailabilityRulesRequest) when calling interceptor")
+					}
+					return s.ReservationService.ListAvailabilityRules(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListAvailabilityRulesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListAvailabilityRulesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListAvailabilityRulesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListAvailabilityRulesResponse and nil error while calling ListAvailabilityRules. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveListAvailabilityRulesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListAvailabilityRules")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListAvailabilityRulesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ReservationService.ListAvailabilityRules
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListAvailabilityRulesRequest) (*ListAvailabilityRulesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListAvailabilityRulesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListAvailabilityRulesRequest) when calling interceptor")
+					}
+					return s.ReservationService.ListAvailabilityRules(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListAvailabilityRulesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListAvailabilityRulesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListAvailabilityRulesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListAvailabilityRulesResponse and nil error while calling ListAvailabilityRules. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveDeleteAvailabilityRule(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDeleteAvailabilityRuleJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDeleteAvailabilityRuleProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *reservationServiceServer) serveDeleteAvailabilityRuleJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteAvailabilityRule")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(DeleteAvailabilityRuleRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ReservationService.DeleteAvailabilityRule
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteAvailabilityRuleRequest) (*DeleteAvailabilityRuleResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteAvailabilityRuleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteAvailabilityRuleRequest) when calling interceptor")
+					}
+					return s.ReservationService.DeleteAvailabilityRule(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteAvailabilityRuleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteAvailabilityRuleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeleteAvailabilityRuleResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteAvailabilityRuleResponse and nil error while calling DeleteAvailabilityRule. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveDeleteAvailabilityRuleProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteAvailabilityRule")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(DeleteAvailabilityRuleRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ReservationService.DeleteAvailabilityRule
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteAvailabilityRuleRequest) (*DeleteAvailabilityRuleResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteAvailabilityRuleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteAvailabilityRuleRequest) when calling interceptor")
+					}
+					return s.ReservationService.DeleteAvailabilityRule(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteAvailabilityRuleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteAvailabilityRuleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeleteAvailabilityRuleResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteAvailabilityRuleResponse and nil error while calling DeleteAvailabilityRule. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveAddAvailabilityRuleException(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveAddAvailabilityRuleExceptionJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveAddAvailabilityRuleExceptionProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *reservationServiceServer) serveAddAvailabilityRuleExceptionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AddAvailabilityRuleException")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(AddAvailabilityRuleExceptionRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ReservationService.AddAvailabilityRuleException
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AddAvailabilityRuleExceptionRequest) (*AddAvailabilityRuleExceptionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddAvailabilityRuleExceptionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddAvailabilityRuleExceptionRequest) when calling interceptor")
+					}
+					return s.ReservationService.AddAvailabilityRuleException(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AddAvailabilityRuleExceptionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AddAvailabilityRuleExceptionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AddAvailabilityRuleExceptionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AddAvailabilityRuleExceptionResponse and nil error while calling AddAvailabilityRuleException. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveAddAvailabilityRuleExceptionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AddAvailabilityRuleException")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(AddAvailabilityRuleExceptionRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ReservationService.AddAvailabilityRuleException
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AddAvailabilityRuleExceptionRequest) (*AddAvailabilityRuleExceptionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AddAvailabilityRuleExceptionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AddAvailabilityRuleExceptionRequest) when calling interceptor")
+					}
+					return s.ReservationService.AddAvailabilityRuleException(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AddAvailabilityRuleExceptionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AddAvailabilityRuleExceptionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *AddAvailabilityRuleExceptionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AddAvailabilityRuleExceptionResponse and nil error while calling AddAvailabilityRuleException. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveCreateAppointmentType(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateAppointmentTypeJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateAppointmentTypeProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *reservationServiceServer) serveCreateAppointmentTypeJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateAppointmentType")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreateAppointmentTypeRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ReservationService.CreateAppointmentType
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateAppointmentTypeRequest) (*CreateAppointmentTypeResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateAppointmentTypeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateAppointmentTypeRequest) when calling interceptor")
+					}
+					return s.ReservationService.CreateAppointmentType(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateAppointmentTypeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateAppointmentTypeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateAppointmentTypeResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateAppointmentTypeResponse and nil error while calling CreateAppointmentType. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveCreateAppointmentTypeProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateAppointmentType")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CreateAppointmentTypeRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ReservationService.CreateAppointmentType
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateAppointmentTypeRequest) (*CreateAppointmentTypeResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateAppointmentTypeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateAppointmentTypeRequest) when calling interceptor")
+					}
+					return s.ReservationService.CreateAppointmentType(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateAppointmentTypeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateAppointmentTypeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateAppointmentTypeResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateAppointmentTypeResponse and nil error while calling CreateAppointmentType. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveListAppointmentTypes(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListAppointmentTypesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListAppointmentTypesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *reservationServiceServer) serveListAppointmentTypesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListAppointmentTypes")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListAppointmentTypesRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ReservationService.ListAppointmentTypes
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListAppointmentTypesRequest) (*ListAppointmentTypesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListAppointmentTypesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListAppointmentTypesRequest) when calling interceptor")
+					}
+					return s.ReservationService.ListAppointmentTypes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListAppointmentTypesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListAppointmentTypesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListAppointmentTypesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListAppointmentTypesResponse and nil error while calling ListAppointmentTypes. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveListAppointmentTypesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListAppointmentTypes")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListAppointmentTypesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ReservationService.ListAppointmentTypes
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListAppointmentTypesRequest) (*ListAppointmentTypesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListAppointmentTypesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListAppointmentTypesRequest) when calling interceptor")
+					}
+					return s.ReservationService.ListAppointmentTypes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListAppointmentTypesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListAppointmentTypesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListAppointmentTypesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListAppointmentTypesResponse and nil error while calling ListAppointmentTypes. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveDeleteAppointmentType(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDeleteAppointmentTypeJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDeleteAppointmentTypeProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *reservationServiceServer) serveDeleteAppointmentTypeJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteAppointmentType")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(DeleteAppointmentTypeRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ReservationService.DeleteAppointmentType
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteAppointmentTypeRequest) (*DeleteAppointmentTypeResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteAppointmentTypeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteAppointmentTypeRequest) when calling interceptor")
+					}
+					return s.ReservationService.DeleteAppointmentType(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteAppointmentTypeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteAppointmentTypeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeleteAppointmentTypeResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteAppointmentTypeResponse and nil error while calling DeleteAppointmentType. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveDeleteAppointmentTypeProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteAppointmentType")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(DeleteAppointmentTypeRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ReservationService.DeleteAppointmentType
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteAppointmentTypeRequest) (*DeleteAppointmentTypeResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteAppointmentTypeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteAppointmentTypeRequest) when calling interceptor")
+					}
+					return s.ReservationService.DeleteAppointmentType(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteAppointmentTypeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteAppointmentTypeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeleteAppointmentTypeResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteAppointmentTypeResponse and nil error while calling DeleteAppointmentType. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveGetAvailableSlots(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetAvailableSlotsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetAvailableSlotsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *reservationServiceServer) serveGetAvailableSlotsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetAvailableSlots")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetAvailableSlotsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ReservationService.GetAvailableSlots
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetAvailableSlotsRequest) (*GetAvailableSlotsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetAvailableSlotsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetAvailableSlotsRequest) when calling interceptor")
+					}
+					return s.ReservationService.GetAvailableSlots(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetAvailableSlotsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetAvailableSlotsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetAvailableSlotsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetAvailableSlotsResponse and nil error while calling GetAvailableSlots. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveGetAvailableSlotsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetAvailableSlots")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetAvailableSlotsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ReservationService.GetAvailableSlots
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetAvailableSlotsRequest) (*GetAvailableSlotsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetAvailableSlotsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetAvailableSlotsRequest) when calling interceptor")
+					}
+					return s.ReservationService.GetAvailableSlots(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetAvailableSlotsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetAvailableSlotsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetAvailableSlotsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetAvailableSlotsResponse and nil error while calling GetAvailableSlots. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveReserveSlot(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveReserveSlotJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveReserveSlotProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *reservationServiceServer) serveReserveSlotJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ReserveSlot")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ReserveSlotRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ReservationService.ReserveSlot
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ReserveSlotRequest) (*ReserveSlotResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReserveSlotRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReserveSlotRequest) when calling interceptor")
+					}
+					return s.ReservationService.ReserveSlot(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReserveSlotResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReserveSlotResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ReserveSlotResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ReserveSlotResponse and nil error while calling ReserveSlot. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveReserveSlotProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ReserveSlot")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ReserveSlotRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ReservationService.ReserveSlot
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ReserveSlotRequest) (*ReserveSlotResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReserveSlotRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReserveSlotRequest) when calling interceptor")
+					}
+					return s.ReservationService.ReserveSlot(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReserveSlotResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReserveSlotResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ReserveSlotResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ReserveSlotResponse and nil error while calling ReserveSlot. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveConfirmReservation(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveConfirmReservationJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveConfirmReservationProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *reservationServiceServer) serveConfirmReservationJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ConfirmReservation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ConfirmReservationRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ReservationService.ConfirmReservation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ConfirmReservationRequest) (*ConfirmReservationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ConfirmReservationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ConfirmReservationRequest) when calling interceptor")
+					}
+					return s.ReservationService.ConfirmReservation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ConfirmReservationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ConfirmReservationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ConfirmReservationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ConfirmReservationResponse and nil error while calling ConfirmReservation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveConfirmReservationProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ConfirmReservation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ConfirmReservationRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ReservationService.ConfirmReservation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ConfirmReservationRequest) (*ConfirmReservationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ConfirmReservationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ConfirmReservationRequest) when calling interceptor")
+					}
+					return s.ReservationService.ConfirmReservation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ConfirmReservationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ConfirmReservationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ConfirmReservationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ConfirmReservationResponse and nil error while calling ConfirmReservation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveExtendHold(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveExtendHoldJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveExtendHoldProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *reservationServiceServer) serveExtendHoldJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ExtendHold")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ExtendHoldRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ReservationService.ExtendHold
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ExtendHoldRequest) (*ExtendHoldResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ExtendHoldRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ExtendHoldRequest) when calling interceptor")
+					}
+					return s.ReservationService.ExtendHold(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ExtendHoldResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ExtendHoldResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ExtendHoldResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ExtendHoldResponse and nil error while calling ExtendHold. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveExtendHoldProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ExtendHold")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ExtendHoldRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ReservationService.ExtendHold
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ExtendHoldRequest) (*ExtendHoldResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ExtendHoldRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ExtendHoldRequest) when calling interceptor")
+					}
+					return s.ReservationService.ExtendHold(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ExtendHoldResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ExtendHoldResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ExtendHoldResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ExtendHoldResponse and nil error while calling ExtendHold. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveCancelReservation(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCancelReservationJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCancelReservationProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *reservationServiceServer) serveCancelReservationJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CancelReservation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CancelReservationRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ReservationService.CancelReservation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CancelReservationRequest) (*CancelReservationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CancelReservationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CancelReservationRequest) when calling interceptor")
+					}
+					return s.ReservationService.CancelReservation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CancelReservationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CancelReservationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CancelReservationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CancelReservationResponse and nil error while calling CancelReservation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveCancelReservationProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CancelReservation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CancelReservationRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ReservationService.CancelReservation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CancelReservationRequest) (*CancelReservationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CancelReservationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CancelReservationRequest) when calling interceptor")
+					}
+					return s.ReservationService.CancelReservation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CancelReservationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CancelReservationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CancelReservationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CancelReservationResponse and nil error while calling CancelReservation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveRescheduleReservation(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRescheduleReservationJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRescheduleReservationProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *reservationServiceServer) serveRescheduleReservationJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RescheduleReservation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RescheduleReservationRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ReservationService.RescheduleReservation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RescheduleReservationRequest) (*RescheduleReservationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RescheduleReservationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RescheduleReservationRequest) when calling interceptor")
+					}
+					return s.ReservationService.RescheduleReservation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RescheduleReservationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RescheduleReservationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RescheduleReservationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RescheduleReservationResponse and nil error while calling RescheduleReservation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveRescheduleReservationProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RescheduleReservation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RescheduleReservationRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ReservationService.RescheduleReservation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RescheduleReservationRequest) (*RescheduleReservationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RescheduleReservationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RescheduleReservationRequest) when calling interceptor")
+					}
+					return s.ReservationService.RescheduleReservation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RescheduleReservationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RescheduleReservationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RescheduleReservationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RescheduleReservationResponse and nil error while calling RescheduleReservation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveCreateProvider(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateProviderJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateProviderProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *reservationServiceServer) serveCreateProviderJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateProvider")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreateProviderRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ReservationService.CreateProvider
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateProviderRequest) (*CreateProviderResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateProviderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateProviderRequest) when calling interceptor")
+					}
+					return s.ReservationService.CreateProvider(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateProviderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateProviderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateProviderResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateProviderResponse and nil error while calling CreateProvider. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveCreateProviderProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateProvider")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CreateProviderRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ReservationService.CreateProvider
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateProviderRequest) (*CreateProviderResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateProviderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateProviderRequest) when calling interceptor")
+					}
+					return s.ReservationService.CreateProvider(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateProviderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateProviderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateProviderResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateProviderResponse and nil error while calling CreateProvider. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveGetProvider(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetProviderJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetProviderProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *reservationServiceServer) serveGetProviderJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetProvider")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetProviderRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ReservationService.GetProvider
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetProviderRequest) (*GetProviderResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetProviderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetProviderRequest) when calling interceptor")
+					}
+					return s.ReservationService.GetProvider(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetProviderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetProviderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetProviderResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetProviderResponse and nil error while calling GetProvider. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveGetProviderProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetProvider")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetProviderRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ReservationService.GetProvider
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetProviderRequest) (*GetProviderResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetProviderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetProviderRequest) when calling interceptor")
+					}
+					return s.ReservationService.GetProvider(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetProviderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetProviderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetProviderResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetProviderResponse and nil error while calling GetProvider. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveUpdateProvider(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdateProviderJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdateProviderProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *reservationServiceServer) serveUpdateProviderJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateProvider")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UpdateProviderRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ReservationService.UpdateProvider
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateProviderRequest) (*UpdateProviderResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateProviderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateProviderRequest) when calling interceptor")
+					}
+					return s.ReservationService.UpdateProvider(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateProviderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateProviderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateProviderResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateProviderResponse and nil error while calling UpdateProvider. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveUpdateProviderProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateProvider")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UpdateProviderRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ReservationService.UpdateProvider
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateProviderRequest) (*UpdateProviderResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateProviderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateProviderRequest) when calling interceptor")
+					}
+					return s.ReservationService.UpdateProvider(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateProviderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateProviderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateProviderResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateProviderResponse and nil error while calling UpdateProvider. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveDeactivateProvider(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDeactivateProviderJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDeactivateProviderProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *reservationServiceServer) serveDeactivateProviderJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeactivateProvider")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(DeactivateProviderRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ReservationService.DeactivateProvider
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeactivateProviderRequest) (*DeactivateProviderResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeactivateProviderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeactivateProviderRequest) when calling interceptor")
+					}
+					return s.ReservationService.DeactivateProvider(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeactivateProviderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeactivateProviderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeactivateProviderResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeactivateProviderResponse and nil error while calling DeactivateProvider. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reservationServiceServer) serveDeactivateProviderProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeactivateProvider")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(DeactivateProviderRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ReservationService.DeactivateProvider
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeactivateProviderRequest) (*DeactivateProviderResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeactivateProviderRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeactivateProviderRequest) when calling interceptor")
+					}
+					return s.ReservationService.DeactivateProvider(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeactivateProviderResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeactivateProviderResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeactivateProviderResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
 
-// NewReservationServiceServer builds a TwirpServer that can be used as an http.Handler to handle
-// HTTP requests that are routed to the right method in the provided svc implementation.
-// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
-func NewReservationServiceServer(svc ReservationService, opts ...interface{}) TwirpServer {
-	serverOpts := newServerOpts(opts)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeactivateProviderResponse and nil error while calling DeactivateProvider. nil responses are not supported"))
+		return
+	}
 
-	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
-	jsonSkipDefaults := false
-	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
-	jsonCamelCase := false
-	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
-	var pathPrefix string
-	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
 	}
 
-	return &reservationServiceServer{
-		ReservationService: svc,
-		hooks:              serverOpts.Hooks,
-		interceptor:        twirp.ChainInterceptors(serverOpts.Interceptors...),
-		pathPrefix:         pathPrefix,
-		jsonSkipDefaults:   jsonSkipDefaults,
-		jsonCamelCase:      jsonCamelCase,
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
 	}
+	callResponseSent(ctx, s.hooks)
 }
 
-// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
-// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
-func (s *reservationServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
-	writeError(ctx, resp, err, s.hooks)
+func (s *reservationServiceServer) serveListProviders(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListProvidersJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListProvidersProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
 }
 
-// handleRequestBodyError is used to handle error when the twirp server cannot read request
-func (s *reservationServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
-	if context.Canceled == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+func (s *reservationServiceServer) serveListProvidersJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListProviders")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
 		return
 	}
-	if context.DeadlineExceeded == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListProvidersRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
-}
 
-// ReservationServicePathPrefix is a convenience constant that may identify URL paths.
-// Should be used with caution, it only matches routes generated by Twirp Go clients,
-// with the default "/twirp" prefix and default CamelCase service and method names.
-// More info: https://twitchtv.github.io/twirp/docs/routing.html
-const ReservationServicePathPrefix = "/twirp/reservation.ReservationService/"
+	handler := s.ReservationService.ListProviders
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListProvidersRequest) (*ListProvidersResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListProvidersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListProvidersRequest) when calling interceptor")
+					}
+					return s.ReservationService.ListProviders(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListProvidersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListProvidersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
 
-func (s *reservationServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
-	ctx = ctxsetters.WithPackageName(ctx, "reservation")
-	ctx = ctxsetters.WithServiceName(ctx, "ReservationService")
-	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+	// Call service method
+	var respContent *ListProvidersResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
 
-	var err error
-	ctx, err = callRequestReceived(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
 		return
 	}
-
-	if req.Method != "POST" {
-		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListProvidersResponse and nil error while calling ListProviders. nil responses are not supported"))
 		return
 	}
 
-	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
-	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
-	if pkgService != "reservation.ReservationService" {
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
 		return
 	}
-	if prefix != s.pathPrefix {
-		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
 	}
+	callResponseSent(ctx, s.hooks)
+}
 
-	switch method {
-	case "SetAvailability":
-		s.serveSetAvailability(ctx, resp, req)
-		return
-	case "GetAvailableSlots":
-		s.serveGetAvailableSlots(ctx, resp, req)
-		return
-	case "ReserveSlot":
-		s.serveReserveSlot(ctx, resp, req)
-		return
-	case "ConfirmReservation":
-		s.serveConfirmReservation(ctx, resp, req)
+func (s *reservationServiceServer) serveListProvidersProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListProviders")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
 		return
-	case "CreateProvider":
-		s.serveCreateProvider(ctx, resp, req)
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
-	case "GetProvider":
-		s.serveGetProvider(ctx, resp, req)
+	}
+	reqContent := new(ListProvidersRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
-	case "GetReservedSlotsByProvider":
-		s.serveGetReservedSlotsByProvider(ctx, resp, req)
+	}
+
+	handler := s.ReservationService.ListProviders
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListProvidersRequest) (*ListProvidersResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListProvidersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListProvidersRequest) when calling interceptor")
+					}
+					return s.ReservationService.ListProviders(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListProvidersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListProvidersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListProvidersResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
 		return
-	case "GetReservedSlotsByClient":
-		s.serveGetReservedSlotsByClient(ctx, resp, req)
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListProvidersResponse and nil error while calling ListProviders. nil responses are not supported"))
 		return
-	default:
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
 		return
 	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (s *reservationServiceServer) serveSetAvailability(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *reservationServiceServer) serveCreateClient(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1036,9 +6916,9 @@ func (s *reservationServiceServer) serveSetAvailability(ctx context.Context, res
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveSetAvailabilityJSON(ctx, resp, req)
+		s.serveCreateClientJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveSetAvailabilityProtobuf(ctx, resp, req)
+		s.serveCreateClientProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1046,9 +6926,9 @@ func (s *reservationServiceServer) serveSetAvailability(ctx context.Context, res
 	}
 }
 
-func (s *reservationServiceServer) serveSetAvailabilityJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *reservationServiceServer) serveCreateClientJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SetAvailability")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateClient")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1061,29 +6941,29 @@ func (s *reservationServiceServer) serveSetAvailabilityJSON(ctx context.Context,
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(SetAvailabilityRequest)
+	reqContent := new(CreateClientRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.ReservationService.SetAvailability
+	handler := s.ReservationService.CreateClient
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *SetAvailabilityRequest) (*SetAvailabilityResponse, error) {
+		handler = func(ctx context.Context, req *CreateClientRequest) (*CreateClientResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*SetAvailabilityRequest)
+					typedReq, ok := req.(*CreateClientRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*SetAvailabilityRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*CreateClientRequest) when calling interceptor")
 					}
-					return s.ReservationService.SetAvailability(ctx, typedReq)
+					return s.ReservationService.CreateClient(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*SetAvailabilityResponse)
+				typedResp, ok := resp.(*CreateClientResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*SetAvailabilityResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateClientResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1092,7 +6972,7 @@ func (s *reservationServiceServer) serveSetAvailabilityJSON(ctx context.Context,
 	}
 
 	// Call service method
-	var respContent *SetAvailabilityResponse
+	var respContent *CreateClientResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1103,7 +6983,7 @@ func (s *reservationServiceServer) serveSetAvailabilityJSON(ctx context.Context,
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *SetAvailabilityResponse and nil error while calling SetAvailability. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateClientResponse and nil error while calling CreateClient. nil responses are not supported"))
 		return
 	}
 
@@ -1129,9 +7009,9 @@ func (s *reservationServiceServer) serveSetAvailabilityJSON(ctx context.Context,
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *reservationServiceServer) serveSetAvailabilityProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *reservationServiceServer) serveCreateClientProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SetAvailability")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateClient")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1143,28 +7023,28 @@ func (s *reservationServiceServer) serveSetAvailabilityProtobuf(ctx context.Cont
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(SetAvailabilityRequest)
+	reqContent := new(CreateClientRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.ReservationService.SetAvailability
+	handler := s.ReservationService.CreateClient
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *SetAvailabilityRequest) (*SetAvailabilityResponse, error) {
+		handler = func(ctx context.Context, req *CreateClientRequest) (*CreateClientResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*SetAvailabilityRequest)
+					typedReq, ok := req.(*CreateClientRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*SetAvailabilityRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*CreateClientRequest) when calling interceptor")
 					}
-					return s.ReservationService.SetAvailability(ctx, typedReq)
+					return s.ReservationService.CreateClient(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*SetAvailabilityResponse)
+				typedResp, ok := resp.(*CreateClientResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*SetAvailabilityResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateClientResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1173,7 +7053,7 @@ func (s *reservationServiceServer) serveSetAvailabilityProtobuf(ctx context.Cont
 	}
 
 	// Call service method
-	var respContent *SetAvailabilityResponse
+	var respContent *CreateClientResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1184,7 +7064,7 @@ func (s *reservationServiceServer) serveSetAvailabilityProtobuf(ctx context.Cont
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *SetAvailabilityResponse and nil error while calling SetAvailability. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateClientResponse and nil error while calling CreateClient. nil responses are not supported"))
 		return
 	}
 
@@ -1208,7 +7088,7 @@ func (s *reservationServiceServer) serveSetAvailabilityProtobuf(ctx context.Cont
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *reservationServiceServer) serveGetAvailableSlots(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *reservationServiceServer) serveGetClient(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1216,9 +7096,9 @@ func (s *reservationServiceServer) serveGetAvailableSlots(ctx context.Context, r
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetAvailableSlotsJSON(ctx, resp, req)
+		s.serveGetClientJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetAvailableSlotsProtobuf(ctx, resp, req)
+		s.serveGetClientProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1226,9 +7106,9 @@ func (s *reservationServiceServer) serveGetAvailableSlots(ctx context.Context, r
 	}
 }
 
-func (s *reservationServiceServer) serveGetAvailableSlotsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *reservationServiceServer) serveGetClientJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetAvailableSlots")
+	ctx = ctxsetters.WithMethodName(ctx, "GetClient")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1241,29 +7121,29 @@ func (s *reservationServiceServer) serveGetAvailableSlotsJSON(ctx context.Contex
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(GetAvailableSlotsRequest)
+	reqContent := new(GetClientRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.ReservationService.GetAvailableSlots
+	handler := s.ReservationService.GetClient
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetAvailableSlotsRequest) (*GetAvailableSlotsResponse, error) {
+		handler = func(ctx context.Context, req *GetClientRequest) (*GetClientResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetAvailableSlotsRequest)
+					typedReq, ok := req.(*GetClientRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetAvailableSlotsRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetClientRequest) when calling interceptor")
 					}
-					return s.ReservationService.GetAvailableSlots(ctx, typedReq)
+					return s.ReservationService.GetClient(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetAvailableSlotsResponse)
+				typedResp, ok := resp.(*GetClientResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetAvailableSlotsResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetClientResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1272,7 +7152,7 @@ func (s *reservationServiceServer) serveGetAvailableSlotsJSON(ctx context.Contex
 	}
 
 	// Call service method
-	var respContent *GetAvailableSlotsResponse
+	var respContent *GetClientResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1283,7 +7163,7 @@ func (s *reservationServiceServer) serveGetAvailableSlotsJSON(ctx context.Contex
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetAvailableSlotsResponse and nil error while calling GetAvailableSlots. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetClientResponse and nil error while calling GetClient. nil responses are not supported"))
 		return
 	}
 
@@ -1309,9 +7189,9 @@ func (s *reservationServiceServer) serveGetAvailableSlotsJSON(ctx context.Contex
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *reservationServiceServer) serveGetAvailableSlotsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *reservationServiceServer) serveGetClientProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetAvailableSlots")
+	ctx = ctxsetters.WithMethodName(ctx, "GetClient")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1323,28 +7203,28 @@ func (s *reservationServiceServer) serveGetAvailableSlotsProtobuf(ctx context.Co
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(GetAvailableSlotsRequest)
+	reqContent := new(GetClientRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.ReservationService.GetAvailableSlots
+	handler := s.ReservationService.GetClient
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetAvailableSlotsRequest) (*GetAvailableSlotsResponse, error) {
+		handler = func(ctx context.Context, req *GetClientRequest) (*GetClientResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetAvailableSlotsRequest)
+					typedReq, ok := req.(*GetClientRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetAvailableSlotsRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetClientRequest) when calling interceptor")
 					}
-					return s.ReservationService.GetAvailableSlots(ctx, typedReq)
+					return s.ReservationService.GetClient(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetAvailableSlotsResponse)
+				typedResp, ok := resp.(*GetClientResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetAvailableSlotsResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetClientResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1353,7 +7233,7 @@ func (s *reservationServiceServer) serveGetAvailableSlotsProtobuf(ctx context.Co
 	}
 
 	// Call service method
-	var respContent *GetAvailableSlotsResponse
+	var respContent *GetClientResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1364,7 +7244,7 @@ func (s *reservationServiceServer) serveGetAvailableSlotsProtobuf(ctx context.Co
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetAvailableSlotsResponse and nil error while calling GetAvailableSlots. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetClientResponse and nil error while calling GetClient. nil responses are not supported"))
 		return
 	}
 
@@ -1388,7 +7268,7 @@ func (s *reservationServiceServer) serveGetAvailableSlotsProtobuf(ctx context.Co
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *reservationServiceServer) serveReserveSlot(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *reservationServiceServer) serveUpdateClient(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1396,9 +7276,9 @@ func (s *reservationServiceServer) serveReserveSlot(ctx context.Context, resp ht
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveReserveSlotJSON(ctx, resp, req)
+		s.serveUpdateClientJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveReserveSlotProtobuf(ctx, resp, req)
+		s.serveUpdateClientProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1406,9 +7286,9 @@ func (s *reservationServiceServer) serveReserveSlot(ctx context.Context, resp ht
 	}
 }
 
-func (s *reservationServiceServer) serveReserveSlotJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *reservationServiceServer) serveUpdateClientJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "ReserveSlot")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateClient")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1421,29 +7301,29 @@ func (s *reservationServiceServer) serveReserveSlotJSON(ctx context.Context, res
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(ReserveSlotRequest)
+	reqContent := new(UpdateClientRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.ReservationService.ReserveSlot
+	handler := s.ReservationService.UpdateClient
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ReserveSlotRequest) (*ReserveSlotResponse, error) {
+		handler = func(ctx context.Context, req *UpdateClientRequest) (*UpdateClientResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ReserveSlotRequest)
+					typedReq, ok := req.(*UpdateClientRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ReserveSlotRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateClientRequest) when calling interceptor")
 					}
-					return s.ReservationService.ReserveSlot(ctx, typedReq)
+					return s.ReservationService.UpdateClient(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ReserveSlotResponse)
+				typedResp, ok := resp.(*UpdateClientResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ReserveSlotResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateClientResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1452,7 +7332,7 @@ func (s *reservationServiceServer) serveReserveSlotJSON(ctx context.Context, res
 	}
 
 	// Call service method
-	var respContent *ReserveSlotResponse
+	var respContent *UpdateClientResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1463,7 +7343,7 @@ func (s *reservationServiceServer) serveReserveSlotJSON(ctx context.Context, res
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ReserveSlotResponse and nil error while calling ReserveSlot. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateClientResponse and nil error while calling UpdateClient. nil responses are not supported"))
 		return
 	}
 
@@ -1489,9 +7369,9 @@ func (s *reservationServiceServer) serveReserveSlotJSON(ctx context.Context, res
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *reservationServiceServer) serveReserveSlotProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *reservationServiceServer) serveUpdateClientProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "ReserveSlot")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateClient")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1503,28 +7383,28 @@ func (s *reservationServiceServer) serveReserveSlotProtobuf(ctx context.Context,
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(ReserveSlotRequest)
+	reqContent := new(UpdateClientRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.ReservationService.ReserveSlot
+	handler := s.ReservationService.UpdateClient
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ReserveSlotRequest) (*ReserveSlotResponse, error) {
+		handler = func(ctx context.Context, req *UpdateClientRequest) (*UpdateClientResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ReserveSlotRequest)
+					typedReq, ok := req.(*UpdateClientRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ReserveSlotRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateClientRequest) when calling interceptor")
 					}
-					return s.ReservationService.ReserveSlot(ctx, typedReq)
+					return s.ReservationService.UpdateClient(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ReserveSlotResponse)
+				typedResp, ok := resp.(*UpdateClientResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ReserveSlotResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateClientResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1533,7 +7413,7 @@ func (s *reservationServiceServer) serveReserveSlotProtobuf(ctx context.Context,
 	}
 
 	// Call service method
-	var respContent *ReserveSlotResponse
+	var respContent *UpdateClientResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1544,7 +7424,7 @@ func (s *reservationServiceServer) serveReserveSlotProtobuf(ctx context.Context,
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ReserveSlotResponse and nil error while calling ReserveSlot. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateClientResponse and nil error while calling UpdateClient. nil responses are not supported"))
 		return
 	}
 
@@ -1568,7 +7448,7 @@ func (s *reservationServiceServer) serveReserveSlotProtobuf(ctx context.Context,
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *reservationServiceServer) serveConfirmReservation(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *reservationServiceServer) serveGetReservedSlotsByProvider(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1576,9 +7456,9 @@ func (s *reservationServiceServer) serveConfirmReservation(ctx context.Context,
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveConfirmReservationJSON(ctx, resp, req)
+		s.serveGetReservedSlotsByProviderJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveConfirmReservationProtobuf(ctx, resp, req)
+		s.serveGetReservedSlotsByProviderProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1586,9 +7466,9 @@ func (s *reservationServiceServer) serveConfirmReservation(ctx context.Context,
 	}
 }
 
-func (s *reservationServiceServer) serveConfirmReservationJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *reservationServiceServer) serveGetReservedSlotsByProviderJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "ConfirmReservation")
+	ctx = ctxsetters.WithMethodName(ctx, "GetReservedSlotsByProvider")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1601,29 +7481,29 @@ func (s *reservationServiceServer) serveConfirmReservationJSON(ctx context.Conte
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(ConfirmReservationRequest)
+	reqContent := new(GetReservedSlotsByProviderRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.ReservationService.ConfirmReservation
+	handler := s.ReservationService.GetReservedSlotsByProvider
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ConfirmReservationRequest) (*ConfirmReservationResponse, error) {
+		handler = func(ctx context.Context, req *GetReservedSlotsByProviderRequest) (*GetReservedSlotsByProviderResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ConfirmReservationRequest)
+					typedReq, ok := req.(*GetReservedSlotsByProviderRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ConfirmReservationRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetReservedSlotsByProviderRequest) when calling interceptor")
 					}
-					return s.ReservationService.ConfirmReservation(ctx, typedReq)
+					return s.ReservationService.GetReservedSlotsByProvider(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ConfirmReservationResponse)
+				typedResp, ok := resp.(*GetReservedSlotsByProviderResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ConfirmReservationResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetReservedSlotsByProviderResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1632,7 +7512,7 @@ func (s *reservationServiceServer) serveConfirmReservationJSON(ctx context.Conte
 	}
 
 	// Call service method
-	var respContent *ConfirmReservationResponse
+	var respContent *GetReservedSlotsByProviderResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1643,7 +7523,7 @@ func (s *reservationServiceServer) serveConfirmReservationJSON(ctx context.Conte
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ConfirmReservationResponse and nil error while calling ConfirmReservation. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetReservedSlotsByProviderResponse and nil error while calling GetReservedSlotsByProvider. nil responses are not supported"))
 		return
 	}
 
@@ -1669,9 +7549,9 @@ func (s *reservationServiceServer) serveConfirmReservationJSON(ctx context.Conte
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *reservationServiceServer) serveConfirmReservationProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *reservationServiceServer) serveGetReservedSlotsByProviderProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "ConfirmReservation")
+	ctx = ctxsetters.WithMethodName(ctx, "GetReservedSlotsByProvider")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1683,28 +7563,28 @@ func (s *reservationServiceServer) serveConfirmReservationProtobuf(ctx context.C
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(ConfirmReservationRequest)
+	reqContent := new(GetReservedSlotsByProviderRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.ReservationService.ConfirmReservation
+	handler := s.ReservationService.GetReservedSlotsByProvider
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ConfirmReservationRequest) (*ConfirmReservationResponse, error) {
+		handler = func(ctx context.Context, req *GetReservedSlotsByProviderRequest) (*GetReservedSlotsByProviderResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ConfirmReservationRequest)
+					typedReq, ok := req.(*GetReservedSlotsByProviderRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ConfirmReservationRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetReservedSlotsByProviderRequest) when calling interceptor")
 					}
-					return s.ReservationService.ConfirmReservation(ctx, typedReq)
+					return s.ReservationService.GetReservedSlotsByProvider(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ConfirmReservationResponse)
+				typedResp, ok := resp.(*GetReservedSlotsByProviderResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ConfirmReservationResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetReservedSlotsByProviderResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1713,7 +7593,7 @@ func (s *reservationServiceServer) serveConfirmReservationProtobuf(ctx context.C
 	}
 
 	// Call service method
-	var respContent *ConfirmReservationResponse
+	var respContent *GetReservedSlotsByProviderResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1724,7 +7604,7 @@ func (s *reservationServiceServer) serveConfirmReservationProtobuf(ctx context.C
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ConfirmReservationResponse and nil error while calling ConfirmReservation. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetReservedSlotsByProviderResponse and nil error while calling GetReservedSlotsByProvider. nil responses are not supported"))
 		return
 	}
 
@@ -1748,7 +7628,7 @@ func (s *reservationServiceServer) serveConfirmReservationProtobuf(ctx context.C
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *reservationServiceServer) serveCreateProvider(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *reservationServiceServer) serveGetReservedSlotsByClient(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1756,9 +7636,9 @@ func (s *reservationServiceServer) serveCreateProvider(ctx context.Context, resp
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveCreateProviderJSON(ctx, resp, req)
+		s.serveGetReservedSlotsByClientJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveCreateProviderProtobuf(ctx, resp, req)
+		s.serveGetReservedSlotsByClientProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1766,9 +7646,9 @@ func (s *reservationServiceServer) serveCreateProvider(ctx context.Context, resp
 	}
 }
 
-func (s *reservationServiceServer) serveCreateProviderJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *reservationServiceServer) serveGetReservedSlotsByClientJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "CreateProvider")
+	ctx = ctxsetters.WithMethodName(ctx, "GetReservedSlotsByClient")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1781,29 +7661,29 @@ func (s *reservationServiceServer) serveCreateProviderJSON(ctx context.Context,
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(CreateProviderRequest)
+	reqContent := new(GetReservedSlotsByClientRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.ReservationService.CreateProvider
+	handler := s.ReservationService.GetReservedSlotsByClient
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *CreateProviderRequest) (*CreateProviderResponse, error) {
+		handler = func(ctx context.Context, req *GetReservedSlotsByClientRequest) (*GetReservedSlotsByClientResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreateProviderRequest)
+					typedReq, ok := req.(*GetReservedSlotsByClientRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreateProviderRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetReservedSlotsByClientRequest) when calling interceptor")
 					}
-					return s.ReservationService.CreateProvider(ctx, typedReq)
+					return s.ReservationService.GetReservedSlotsByClient(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*CreateProviderResponse)
+				typedResp, ok := resp.(*GetReservedSlotsByClientResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*CreateProviderResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetReservedSlotsByClientResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1812,7 +7692,7 @@ func (s *reservationServiceServer) serveCreateProviderJSON(ctx context.Context,
 	}
 
 	// Call service method
-	var respContent *CreateProviderResponse
+	var respContent *GetReservedSlotsByClientResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1823,7 +7703,7 @@ func (s *reservationServiceServer) serveCreateProviderJSON(ctx context.Context,
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateProviderResponse and nil error while calling CreateProvider. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetReservedSlotsByClientResponse and nil error while calling GetReservedSlotsByClient. nil responses are not supported"))
 		return
 	}
 
@@ -1849,9 +7729,9 @@ func (s *reservationServiceServer) serveCreateProviderJSON(ctx context.Context,
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *reservationServiceServer) serveCreateProviderProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *reservationServiceServer) serveGetReservedSlotsByClientProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "CreateProvider")
+	ctx = ctxsetters.WithMethodName(ctx, "GetReservedSlotsByClient")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1863,28 +7743,28 @@ func (s *reservationServiceServer) serveCreateProviderProtobuf(ctx context.Conte
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(CreateProviderRequest)
+	reqContent := new(GetReservedSlotsByClientRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.ReservationService.CreateProvider
+	handler := s.ReservationService.GetReservedSlotsByClient
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *CreateProviderRequest) (*CreateProviderResponse, error) {
+		handler = func(ctx context.Context, req *GetReservedSlotsByClientRequest) (*GetReservedSlotsByClientResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreateProviderRequest)
+					typedReq, ok := req.(*GetReservedSlotsByClientRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreateProviderRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetReservedSlotsByClientRequest) when calling interceptor")
 					}
-					return s.ReservationService.CreateProvider(ctx, typedReq)
+					return s.ReservationService.GetReservedSlotsByClient(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*CreateProviderResponse)
+				typedResp, ok := resp.(*GetReservedSlotsByClientResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*CreateProviderResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetReservedSlotsByClientResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1893,7 +7773,7 @@ func (s *reservationServiceServer) serveCreateProviderProtobuf(ctx context.Conte
 	}
 
 	// Call service method
-	var respContent *CreateProviderResponse
+	var respContent *GetReservedSlotsByClientResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1904,7 +7784,7 @@ func (s *reservationServiceServer) serveCreateProviderProtobuf(ctx context.Conte
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateProviderResponse and nil error while calling CreateProvider. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetReservedSlotsByClientResponse and nil error while calling GetReservedSlotsByClient. nil responses are not supported"))
 		return
 	}
 
@@ -1928,7 +7808,7 @@ func (s *reservationServiceServer) serveCreateProviderProtobuf(ctx context.Conte
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *reservationServiceServer) serveGetProvider(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *reservationServiceServer) serveJoinWaitlist(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1936,9 +7816,9 @@ func (s *reservationServiceServer) serveGetProvider(ctx context.Context, resp ht
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetProviderJSON(ctx, resp, req)
+		s.serveJoinWaitlistJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetProviderProtobuf(ctx, resp, req)
+		s.serveJoinWaitlistProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1946,9 +7826,9 @@ func (s *reservationServiceServer) serveGetProvider(ctx context.Context, resp ht
 	}
 }
 
-func (s *reservationServiceServer) serveGetProviderJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *reservationServiceServer) serveJoinWaitlistJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetProvider")
+	ctx = ctxsetters.WithMethodName(ctx, "JoinWaitlist")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1961,29 +7841,29 @@ func (s *reservationServiceServer) serveGetProviderJSON(ctx context.Context, res
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(GetProviderRequest)
+	reqContent := new(JoinWaitlistRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.ReservationService.GetProvider
+	handler := s.ReservationService.JoinWaitlist
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetProviderRequest) (*GetProviderResponse, error) {
+		handler = func(ctx context.Context, req *JoinWaitlistRequest) (*JoinWaitlistResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetProviderRequest)
+					typedReq, ok := req.(*JoinWaitlistRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetProviderRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*JoinWaitlistRequest) when calling interceptor")
 					}
-					return s.ReservationService.GetProvider(ctx, typedReq)
+					return s.ReservationService.JoinWaitlist(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetProviderResponse)
+				typedResp, ok := resp.(*JoinWaitlistResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetProviderResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*JoinWaitlistResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1992,7 +7872,7 @@ func (s *reservationServiceServer) serveGetProviderJSON(ctx context.Context, res
 	}
 
 	// Call service method
-	var respContent *GetProviderResponse
+	var respContent *JoinWaitlistResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2003,7 +7883,7 @@ func (s *reservationServiceServer) serveGetProviderJSON(ctx context.Context, res
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetProviderResponse and nil error while calling GetProvider. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *JoinWaitlistResponse and nil error while calling JoinWaitlist. nil responses are not supported"))
 		return
 	}
 
@@ -2029,9 +7909,9 @@ func (s *reservationServiceServer) serveGetProviderJSON(ctx context.Context, res
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *reservationServiceServer) serveGetProviderProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *reservationServiceServer) serveJoinWaitlistProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetProvider")
+	ctx = ctxsetters.WithMethodName(ctx, "JoinWaitlist")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2043,28 +7923,28 @@ func (s *reservationServiceServer) serveGetProviderProtobuf(ctx context.Context,
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(GetProviderRequest)
+	reqContent := new(JoinWaitlistRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.ReservationService.GetProvider
+	handler := s.ReservationService.JoinWaitlist
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetProviderRequest) (*GetProviderResponse, error) {
+		handler = func(ctx context.Context, req *JoinWaitlistRequest) (*JoinWaitlistResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetProviderRequest)
+					typedReq, ok := req.(*JoinWaitlistRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetProviderRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*JoinWaitlistRequest) when calling interceptor")
 					}
-					return s.ReservationService.GetProvider(ctx, typedReq)
+					return s.ReservationService.JoinWaitlist(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetProviderResponse)
+				typedResp, ok := resp.(*JoinWaitlistResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetProviderResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*JoinWaitlistResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2073,7 +7953,7 @@ func (s *reservationServiceServer) serveGetProviderProtobuf(ctx context.Context,
 	}
 
 	// Call service method
-	var respContent *GetProviderResponse
+	var respContent *JoinWaitlistResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2084,7 +7964,7 @@ func (s *reservationServiceServer) serveGetProviderProtobuf(ctx context.Context,
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetProviderResponse and nil error while calling GetProvider. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *JoinWaitlistResponse and nil error while calling JoinWaitlist. nil responses are not supported"))
 		return
 	}
 
@@ -2108,7 +7988,7 @@ func (s *reservationServiceServer) serveGetProviderProtobuf(ctx context.Context,
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *reservationServiceServer) serveGetReservedSlotsByProvider(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *reservationServiceServer) serveGetWaitlistByClient(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -2116,9 +7996,9 @@ func (s *reservationServiceServer) serveGetReservedSlotsByProvider(ctx context.C
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetReservedSlotsByProviderJSON(ctx, resp, req)
+		s.serveGetWaitlistByClientJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetReservedSlotsByProviderProtobuf(ctx, resp, req)
+		s.serveGetWaitlistByClientProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -2126,9 +8006,9 @@ func (s *reservationServiceServer) serveGetReservedSlotsByProvider(ctx context.C
 	}
 }
 
-func (s *reservationServiceServer) serveGetReservedSlotsByProviderJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *reservationServiceServer) serveGetWaitlistByClientJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetReservedSlotsByProvider")
+	ctx = ctxsetters.WithMethodName(ctx, "GetWaitlistByClient")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2141,29 +8021,29 @@ func (s *reservationServiceServer) serveGetReservedSlotsByProviderJSON(ctx conte
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(GetReservedSlotsByProviderRequest)
+	reqContent := new(GetWaitlistByClientRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.ReservationService.GetReservedSlotsByProvider
+	handler := s.ReservationService.GetWaitlistByClient
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetReservedSlotsByProviderRequest) (*GetReservedSlotsByProviderResponse, error) {
+		handler = func(ctx context.Context, req *GetWaitlistByClientRequest) (*GetWaitlistByClientResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetReservedSlotsByProviderRequest)
+					typedReq, ok := req.(*GetWaitlistByClientRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetReservedSlotsByProviderRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetWaitlistByClientRequest) when calling interceptor")
 					}
-					return s.ReservationService.GetReservedSlotsByProvider(ctx, typedReq)
+					return s.ReservationService.GetWaitlistByClient(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetReservedSlotsByProviderResponse)
+				typedResp, ok := resp.(*GetWaitlistByClientResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetReservedSlotsByProviderResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetWaitlistByClientResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2172,7 +8052,7 @@ func (s *reservationServiceServer) serveGetReservedSlotsByProviderJSON(ctx conte
 	}
 
 	// Call service method
-	var respContent *GetReservedSlotsByProviderResponse
+	var respContent *GetWaitlistByClientResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2183,7 +8063,7 @@ func (s *reservationServiceServer) serveGetReservedSlotsByProviderJSON(ctx conte
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetReservedSlotsByProviderResponse and nil error while calling GetReservedSlotsByProvider. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetWaitlistByClientResponse and nil error while calling GetWaitlistByClient. nil responses are not supported"))
 		return
 	}
 
@@ -2209,9 +8089,9 @@ func (s *reservationServiceServer) serveGetReservedSlotsByProviderJSON(ctx conte
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *reservationServiceServer) serveGetReservedSlotsByProviderProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *reservationServiceServer) serveGetWaitlistByClientProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetReservedSlotsByProvider")
+	ctx = ctxsetters.WithMethodName(ctx, "GetWaitlistByClient")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2223,28 +8103,28 @@ func (s *reservationServiceServer) serveGetReservedSlotsByProviderProtobuf(ctx c
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(GetReservedSlotsByProviderRequest)
+	reqContent := new(GetWaitlistByClientRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.ReservationService.GetReservedSlotsByProvider
+	handler := s.ReservationService.GetWaitlistByClient
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetReservedSlotsByProviderRequest) (*GetReservedSlotsByProviderResponse, error) {
+		handler = func(ctx context.Context, req *GetWaitlistByClientRequest) (*GetWaitlistByClientResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetReservedSlotsByProviderRequest)
+					typedReq, ok := req.(*GetWaitlistByClientRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetReservedSlotsByProviderRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetWaitlistByClientRequest) when calling interceptor")
 					}
-					return s.ReservationService.GetReservedSlotsByProvider(ctx, typedReq)
+					return s.ReservationService.GetWaitlistByClient(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetReservedSlotsByProviderResponse)
+				typedResp, ok := resp.(*GetWaitlistByClientResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetReservedSlotsByProviderResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetWaitlistByClientResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2253,7 +8133,7 @@ func (s *reservationServiceServer) serveGetReservedSlotsByProviderProtobuf(ctx c
 	}
 
 	// Call service method
-	var respContent *GetReservedSlotsByProviderResponse
+	var respContent *GetWaitlistByClientResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2264,7 +8144,7 @@ func (s *reservationServiceServer) serveGetReservedSlotsByProviderProtobuf(ctx c
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetReservedSlotsByProviderResponse and nil error while calling GetReservedSlotsByProvider. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetWaitlistByClientResponse and nil error while calling GetWaitlistByClient. nil responses are not supported"))
 		return
 	}
 
@@ -2288,7 +8168,7 @@ func (s *reservationServiceServer) serveGetReservedSlotsByProviderProtobuf(ctx c
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *reservationServiceServer) serveGetReservedSlotsByClient(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *reservationServiceServer) serveAcceptWaitlistOffer(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -2296,9 +8176,9 @@ func (s *reservationServiceServer) serveGetReservedSlotsByClient(ctx context.Con
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetReservedSlotsByClientJSON(ctx, resp, req)
+		s.serveAcceptWaitlistOfferJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetReservedSlotsByClientProtobuf(ctx, resp, req)
+		s.serveAcceptWaitlistOfferProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -2306,9 +8186,9 @@ func (s *reservationServiceServer) serveGetReservedSlotsByClient(ctx context.Con
 	}
 }
 
-func (s *reservationServiceServer) serveGetReservedSlotsByClientJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *reservationServiceServer) serveAcceptWaitlistOfferJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetReservedSlotsByClient")
+	ctx = ctxsetters.WithMethodName(ctx, "AcceptWaitlistOffer")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2321,29 +8201,29 @@ func (s *reservationServiceServer) serveGetReservedSlotsByClientJSON(ctx context
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(GetReservedSlotsByClientRequest)
+	reqContent := new(AcceptWaitlistOfferRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.ReservationService.GetReservedSlotsByClient
+	handler := s.ReservationService.AcceptWaitlistOffer
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetReservedSlotsByClientRequest) (*GetReservedSlotsByClientResponse, error) {
+		handler = func(ctx context.Context, req *AcceptWaitlistOfferRequest) (*AcceptWaitlistOfferResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetReservedSlotsByClientRequest)
+					typedReq, ok := req.(*AcceptWaitlistOfferRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetReservedSlotsByClientRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AcceptWaitlistOfferRequest) when calling interceptor")
 					}
-					return s.ReservationService.GetReservedSlotsByClient(ctx, typedReq)
+					return s.ReservationService.AcceptWaitlistOffer(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetReservedSlotsByClientResponse)
+				typedResp, ok := resp.(*AcceptWaitlistOfferResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetReservedSlotsByClientResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*AcceptWaitlistOfferResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2352,7 +8232,7 @@ func (s *reservationServiceServer) serveGetReservedSlotsByClientJSON(ctx context
 	}
 
 	// Call service method
-	var respContent *GetReservedSlotsByClientResponse
+	var respContent *AcceptWaitlistOfferResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2363,7 +8243,7 @@ func (s *reservationServiceServer) serveGetReservedSlotsByClientJSON(ctx context
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetReservedSlotsByClientResponse and nil error while calling GetReservedSlotsByClient. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AcceptWaitlistOfferResponse and nil error while calling AcceptWaitlistOffer. nil responses are not supported"))
 		return
 	}
 
@@ -2389,9 +8269,9 @@ func (s *reservationServiceServer) serveGetReservedSlotsByClientJSON(ctx context
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *reservationServiceServer) serveGetReservedSlotsByClientProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *reservationServiceServer) serveAcceptWaitlistOfferProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetReservedSlotsByClient")
+	ctx = ctxsetters.WithMethodName(ctx, "AcceptWaitlistOffer")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2403,28 +8283,28 @@ func (s *reservationServiceServer) serveGetReservedSlotsByClientProtobuf(ctx con
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(GetReservedSlotsByClientRequest)
+	reqContent := new(AcceptWaitlistOfferRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.ReservationService.GetReservedSlotsByClient
+	handler := s.ReservationService.AcceptWaitlistOffer
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetReservedSlotsByClientRequest) (*GetReservedSlotsByClientResponse, error) {
+		handler = func(ctx context.Context, req *AcceptWaitlistOfferRequest) (*AcceptWaitlistOfferResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetReservedSlotsByClientRequest)
+					typedReq, ok := req.(*AcceptWaitlistOfferRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetReservedSlotsByClientRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AcceptWaitlistOfferRequest) when calling interceptor")
 					}
-					return s.ReservationService.GetReservedSlotsByClient(ctx, typedReq)
+					return s.ReservationService.AcceptWaitlistOffer(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetReservedSlotsByClientResponse)
+				typedResp, ok := resp.(*AcceptWaitlistOfferResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetReservedSlotsByClientResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*AcceptWaitlistOfferResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2433,7 +8313,7 @@ func (s *reservationServiceServer) serveGetReservedSlotsByClientProtobuf(ctx con
 	}
 
 	// Call service method
-	var respContent *GetReservedSlotsByClientResponse
+	var respContent *AcceptWaitlistOfferResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2444,7 +8324,7 @@ func (s *reservationServiceServer) serveGetReservedSlotsByClientProtobuf(ctx con
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetReservedSlotsByClientResponse and nil error while calling GetReservedSlotsByClient. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AcceptWaitlistOfferResponse and nil error while calling AcceptWaitlistOffer. nil responses are not supported"))
 		return
 	}
 
@@ -3049,49 +8929,171 @@ func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error)
 }
 
 var twirpFileDescriptor0 = []byte{
-	// 702 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x51, 0x4f, 0x13, 0x4d,
-	0x14, 0x4d, 0x0b, 0x14, 0x7a, 0xfb, 0x7d, 0x18, 0x87, 0x00, 0x65, 0x8d, 0xa1, 0x0e, 0xa0, 0x24,
-	0x4a, 0x9b, 0x80, 0x31, 0x31, 0x3c, 0xd9, 0x92, 0x60, 0x7d, 0x91, 0x2c, 0xc6, 0xa8, 0x31, 0x21,
-	0x53, 0xf6, 0x0a, 0x93, 0xcc, 0xee, 0xd6, 0x9d, 0x69, 0x0d, 0x0f, 0xfe, 0x2b, 0xff, 0x85, 0x7f,
-	0xca, 0x74, 0x77, 0x96, 0xce, 0xec, 0x6e, 0xb7, 0x8d, 0xf1, 0xad, 0x7b, 0xe7, 0xde, 0x73, 0xcf,
-	0x9c, 0x39, 0x73, 0xa7, 0xb0, 0xc9, 0x86, 0xbc, 0x13, 0xa1, 0xc4, 0x68, 0xcc, 0x14, 0x0f, 0x83,
-	0xf6, 0x30, 0x0a, 0x55, 0x48, 0x1a, 0x46, 0x88, 0x9e, 0xc2, 0x66, 0x2f, 0x42, 0xa6, 0xf0, 0x22,
-	0x0a, 0xc7, 0xdc, 0xc3, 0xc8, 0xc5, 0xef, 0x23, 0x94, 0x8a, 0xac, 0x43, 0x95, 0x7b, 0xcd, 0x4a,
-	0xab, 0x72, 0x58, 0x77, 0xab, 0xdc, 0x23, 0x04, 0x96, 0x03, 0xe6, 0x63, 0xb3, 0x1a, 0x47, 0xe2,
-	0xdf, 0xf4, 0x18, 0xb6, 0xb2, 0xc5, 0x72, 0x18, 0x06, 0x12, 0x49, 0x13, 0x56, 0x7d, 0x94, 0x92,
-	0xdd, 0xa0, 0x86, 0x48, 0x3f, 0xe9, 0x3e, 0x90, 0x73, 0x54, 0x73, 0xba, 0xd1, 0xd7, 0xb0, 0x61,
-	0x65, 0x69, 0xd8, 0x45, 0x48, 0x85, 0xb0, 0x75, 0x89, 0xea, 0xcd, 0x98, 0x71, 0xc1, 0x06, 0x5c,
-	0x70, 0x75, 0x97, 0x36, 0xd9, 0x85, 0xc6, 0x50, 0x23, 0x5e, 0xdd, 0xc3, 0x40, 0x1a, 0xea, 0x7b,
-	0xe4, 0x25, 0x80, 0xe2, 0x3e, 0x5e, 0x49, 0x11, 0x2a, 0xd9, 0xac, 0xb6, 0x96, 0x0e, 0x1b, 0xc7,
-	0x9b, 0x6d, 0x53, 0xc1, 0x0f, 0xdc, 0xc7, 0x4b, 0x11, 0x2a, 0xb7, 0xae, 0xf4, 0x2f, 0x49, 0x4f,
-	0x60, 0x3b, 0xd7, 0x70, 0xae, 0x0c, 0xef, 0xa1, 0x79, 0x7e, 0x5f, 0x24, 0x12, 0xa4, 0x85, 0x79,
-	0x12, 0x58, 0xf6, 0x98, 0xba, 0xdf, 0xf6, 0xe4, 0x37, 0x7d, 0x0b, 0x3b, 0x05, 0x80, 0x9a, 0xc7,
-	0x73, 0x58, 0x49, 0xf6, 0x54, 0x29, 0xdb, 0x53, 0x92, 0x43, 0xdf, 0x01, 0x71, 0xe3, 0xe5, 0x24,
-	0xaa, 0x49, 0x6d, 0xc3, 0xea, 0x64, 0x79, 0x4a, 0xa8, 0x36, 0xf9, 0xec, 0x7b, 0xe4, 0x11, 0xd4,
-	0xaf, 0x05, 0xc7, 0x20, 0x5e, 0x4a, 0x18, 0xad, 0x25, 0x81, 0xbe, 0x47, 0x3f, 0xc2, 0x86, 0x85,
-	0xa5, 0xf9, 0x1c, 0xc0, 0xba, 0xc1, 0x60, 0x8a, 0xf9, 0xbf, 0x11, 0xed, 0x7b, 0xa6, 0x7c, 0x55,
-	0x5b, 0xbe, 0x2e, 0xec, 0xf4, 0xc2, 0xe0, 0x1b, 0x8f, 0x7c, 0x77, 0x5a, 0x91, 0x52, 0x5d, 0x0c,
-	0x9d, 0xbe, 0x02, 0xa7, 0x08, 0x63, 0xee, 0xd1, 0x09, 0x58, 0x4b, 0x25, 0xcb, 0x19, 0xf2, 0x31,
-	0x80, 0x54, 0x2c, 0x52, 0x57, 0x13, 0x7b, 0x68, 0xd2, 0xf5, 0x38, 0x32, 0x29, 0x21, 0x3b, 0xb0,
-	0x86, 0x81, 0x97, 0x2c, 0x2e, 0x25, 0xa8, 0x18, 0x78, 0xf1, 0xd2, 0x16, 0xd4, 0xa4, 0x62, 0x6a,
-	0x24, 0x9b, 0xcb, 0x5a, 0xde, 0xf8, 0x8b, 0x7e, 0x82, 0x27, 0xe7, 0xa8, 0xb4, 0x88, 0x5e, 0x7c,
-	0xac, 0xdd, 0xbb, 0xec, 0xf5, 0xf9, 0x2b, 0xc7, 0x70, 0xa0, 0x65, 0xc8, 0x5a, 0x87, 0x1e, 0xfc,
-	0x67, 0xc8, 0x96, 0x3a, 0x68, 0xd7, 0x72, 0x90, 0xa1, 0xdf, 0x19, 0x2a, 0xc6, 0x85, 0x74, 0xad,
-	0x22, 0xea, 0xc2, 0x6e, 0xbe, 0x55, 0x2f, 0x36, 0x49, 0xba, 0x05, 0xcb, 0x46, 0x15, 0xdb, 0x46,
-	0x85, 0xf4, 0x6f, 0xa0, 0x35, 0x1b, 0xf3, 0x5f, 0x92, 0xff, 0x5d, 0x49, 0x2f, 0x84, 0x99, 0xb4,
-	0xa8, 0x87, 0xcb, 0xae, 0x47, 0xf6, 0xdc, 0x96, 0x72, 0xe7, 0x36, 0xc3, 0x15, 0x19, 0x9f, 0xad,
-	0x94, 0xf9, 0xac, 0x66, 0xf9, 0xec, 0xf8, 0x57, 0xcd, 0xda, 0xcd, 0x25, 0x46, 0x63, 0x7e, 0x8d,
-	0xe4, 0x2b, 0x3c, 0xc8, 0x0c, 0x31, 0xb2, 0x67, 0xc9, 0x54, 0x3c, 0x53, 0x9d, 0xfd, 0xf2, 0x24,
-	0x7d, 0x0e, 0x03, 0x78, 0x98, 0x1b, 0x4e, 0xe4, 0xc0, 0x2a, 0x9d, 0x35, 0x0d, 0x9d, 0xa7, 0xf3,
-	0xd2, 0x74, 0x8f, 0x0b, 0x68, 0x18, 0xa3, 0x86, 0x14, 0x1d, 0xb2, 0x39, 0xd0, 0x9c, 0xd6, 0xec,
-	0x04, 0x8d, 0x88, 0x40, 0xf2, 0x03, 0x82, 0xd8, 0x7c, 0x66, 0x4e, 0x21, 0xe7, 0xd9, 0xdc, 0x3c,
-	0xdd, 0xe6, 0x33, 0xac, 0xdb, 0xaf, 0x28, 0xa1, 0x76, 0x69, 0xd1, 0xfb, 0xec, 0xec, 0x95, 0xe6,
-	0x4c, 0x35, 0x31, 0x9e, 0xd1, 0x8c, 0x26, 0xf9, 0x67, 0x38, 0xa3, 0x49, 0xd1, 0x0b, 0xfc, 0x13,
-	0x9c, 0xd9, 0x43, 0x83, 0xb4, 0xb3, 0xf5, 0xe5, 0x73, 0xcb, 0xe9, 0x2c, 0x9c, 0xaf, 0xdb, 0xff,
-	0x88, 0x9f, 0xcd, 0xc2, 0x4b, 0x4f, 0x5e, 0xcc, 0x01, 0xb3, 0xe6, 0x8d, 0x73, 0xb4, 0x60, 0x76,
-	0xd2, 0xb8, 0x7b, 0xf6, 0xa5, 0x7b, 0xc3, 0xd5, 0xed, 0x68, 0xd0, 0xbe, 0x0e, 0xfd, 0x8e, 0xcf,
-	0x82, 0x11, 0x0a, 0x0f, 0x45, 0x84, 0x4c, 0x74, 0x6e, 0x91, 0x09, 0x75, 0x7b, 0x64, 0xe0, 0x1d,
-	0xc9, 0x3b, 0xa9, 0xd0, 0xef, 0xb0, 0x21, 0x3f, 0x35, 0xc2, 0x83, 0x5a, 0xfc, 0x0f, 0xec, 0xe4,
-	0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa5, 0xbb, 0x1b, 0x88, 0x9a, 0x09, 0x00, 0x00,
+	// 2648 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3a, 0x5b, 0x6f, 0x1c, 0x49,
+	0xd5, 0xdb, 0x73, 0xf1, 0xcc, 0x9c, 0xf1, 0x2d, 0xe5, 0x4b, 0xc6, 0x1d, 0x5f, 0xc6, 0x9d, 0x8b,
+	0x9d, 0x64, 0x63, 0x27, 0xd9, 0xcd, 0x7e, 0xca, 0x07, 0x02, 0xec, 0xd8, 0x24, 0x0e, 0x89, 0x76,
+	0xd5, 0x0e, 0x8b, 0x76, 0x41, 0x34, 0xed, 0xe9, 0x72, 0xdc, 0x4a, 0x4f, 0xf7, 0x6c, 0x77, 0x8f,
+	0x9d, 0x41, 0x42, 0x02, 0xf1, 0xc0, 0xf2, 0x00, 0xbf, 0x00, 0x04, 0x02, 0x1e, 0x10, 0x4f, 0x3c,
+	0x21, 0x21, 0x1e, 0x10, 0x7f, 0x85, 0x67, 0x1e, 0x90, 0x78, 0xe4, 0x05, 0x55, 0x75, 0xf5, 0xa5,
+	0xaa, 0x6f, 0x63, 0x13, 0x05, 0x21, 0xf1, 0x36, 0x7d, 0xea, 0x9c, 0xaa, 0x73, 0xaf, 0x73, 0xce,
+	0x14, 0x2c, 0xe8, 0x03, 0x73, 0xdb, 0xc5, 0x1e, 0x76, 0x4f, 0x75, 0xdf, 0x74, 0xec, 0xad, 0x81,
+	0xeb, 0xf8, 0x0e, 0x6a, 0x27, 0x40, 0xca, 0x2f, 0xab, 0xb0, 0xf0, 0xc8, 0xc5, 0xba, 0x8f, 0x3f,
+	0x72, 0x9d, 0x53, 0xd3, 0xc0, 0xae, 0x8a, 0x3f, 0x1b, 0x62, 0xcf, 0x47, 0xd3, 0x50, 0x31, 0x8d,
+	0x8e, 0xd4, 0x95, 0x36, 0x5b, 0x6a, 0xc5, 0x34, 0x10, 0x82, 0x9a, 0xad, 0xf7, 0x71, 0xa7, 0x42,
+	0x21, 0xf4, 0x37, 0xfa, 0x3f, 0x58, 0xf0, 0x2c, 0xc7, 0xd7, 0x8c, 0xa1, 0x4b, 0xb7, 0xd3, 0xfa,
+	0xa6, 0x3d, 0xf4, 0xb1, 0xd7, 0xa9, 0x76, 0xa5, 0xcd, 0xfa, 0x93, 0x77, 0xd4, 0x39, 0xb2, 0xbc,
+	0xc7, 0x56, 0x9f, 0x07, 0x8b, 0x9f, 0x4b, 0x12, 0x21, 0x3c, 0x1a, 0x1e, 0x1f, 0x63, 0x57, 0x3b,
+	0xc2, 0xc7, 0x8e, 0x8b, 0x23, 0xc2, 0x1a, 0x25, 0x94, 0xd4, 0xb9, 0x60, 0x79, 0x97, 0xae, 0x26,
+	0x08, 0x1f, 0xc0, 0x3c, 0x23, 0xd4, 0x8f, 0x7d, 0xec, 0x46, 0x74, 0x75, 0x4a, 0x57, 0x51, 0x51,
+	0xb0, 0xba, 0x43, 0x16, 0x13, 0x64, 0x1f, 0xc0, 0x42, 0xdf, 0xb4, 0x35, 0x0b, 0xeb, 0x86, 0xe6,
+	0x9b, 0xfd, 0xf8, 0xbc, 0x09, 0x4a, 0x57, 0x55, 0x51, 0xdf, 0xb4, 0x9f, 0x61, 0xdd, 0x78, 0x61,
+	0xf6, 0x93, 0xc7, 0x5d, 0x81, 0x16, 0x45, 0xff, 0xae, 0x63, 0xe3, 0x4e, 0x83, 0x4a, 0xde, 0x24,
+	0x80, 0x4f, 0x1d, 0x1b, 0xef, 0x76, 0x60, 0x51, 0xcb, 0x14, 0x9f, 0xae, 0x64, 0xca, 0xb7, 0x7b,
+	0x19, 0x16, 0xb4, 0x2c, 0x01, 0x28, 0x49, 0x26, 0x8b, 0xca, 0x7d, 0x58, 0x14, 0x2d, 0xe4, 0x0d,
+	0x1c, 0xdb, 0xc3, 0xa8, 0x03, 0x8d, 0x3e, 0xf6, 0x3c, 0xfd, 0x25, 0x66, 0x76, 0x0a, 0x3f, 0x95,
+	0x6b, 0x80, 0x1e, 0x63, 0xbf, 0xc4, 0xa4, 0xca, 0x9f, 0x2a, 0x30, 0xc7, 0xa1, 0xb1, 0x7d, 0xc7,
+	0x31, 0xfd, 0xfd, 0x42, 0xd3, 0x67, 0x1a, 0x9e, 0xd0, 0x14, 0x58, 0x3d, 0xd3, 0xe6, 0xe8, 0x6e,
+	0x91, 0xc1, 0xb3, 0xcc, 0x8d, 0xee, 0x15, 0xda, 0x3a, 0xcb, 0xd2, 0x85, 0x66, 0x46, 0x8b, 0x30,
+	0xa1, 0xf7, 0x7c, 0xf3, 0x14, 0x77, 0x9a, 0x5d, 0x69, 0xb3, 0xa9, 0xb2, 0x2f, 0xe5, 0x2f, 0x55,
+	0x58, 0xf8, 0xfa, 0xc0, 0x18, 0x23, 0x74, 0x2e, 0x27, 0xf5, 0xf7, 0xe4, 0x9d, 0x40, 0x83, 0x2c,
+	0x0c, 0x8a, 0xe2, 0x47, 0xba, 0x68, 0xfc, 0x54, 0x2e, 0x18, 0x3f, 0xd5, 0x0b, 0xc6, 0x4f, 0x2d,
+	0x2f, 0x7e, 0xba, 0x29, 0xc5, 0x3e, 0xa9, 0xc7, 0xaa, 0xfd, 0x5c, 0x92, 0x76, 0x1b, 0x50, 0xd7,
+	0x88, 0x3a, 0xde, 0x52, 0x34, 0xed, 0x4e, 0x02, 0x68, 0x11, 0x4b, 0x24, 0xb6, 0x44, 0x13, 0x96,
+	0xc6, 0xd6, 0x0f, 0x24, 0x58, 0xda, 0xc3, 0xd4, 0x09, 0xc6, 0xb0, 0xfd, 0x17, 0x41, 0xee, 0xe9,
+	0x76, 0x0f, 0x5b, 0xda, 0xf1, 0xd0, 0x1f, 0xba, 0x58, 0x4b, 0x64, 0x5f, 0x8f, 0x7a, 0x44, 0x53,
+	0xed, 0x04, 0x18, 0x5f, 0xa5, 0x08, 0x6a, 0x62, 0x9d, 0xf8, 0x9e, 0x8b, 0x75, 0xcf, 0xb1, 0xa9,
+	0x47, 0xb4, 0x54, 0xf6, 0xa5, 0xf4, 0x41, 0xce, 0x62, 0xa1, 0x8c, 0x77, 0xf4, 0x00, 0x16, 0x83,
+	0xb3, 0x2c, 0x6c, 0xa4, 0x39, 0xa9, 0xab, 0x0b, 0xd1, 0x6a, 0x92, 0x0d, 0xe5, 0xf7, 0x15, 0x68,
+	0x86, 0xa7, 0xfc, 0x2f, 0x3b, 0x8c, 0x95, 0x1d, 0x76, 0x60, 0xfe, 0x99, 0xe9, 0x45, 0xb9, 0xd5,
+	0x0b, 0xfd, 0xe3, 0x26, 0xcc, 0x9a, 0x76, 0xcf, 0x1a, 0x1a, 0x58, 0x33, 0x6d, 0x46, 0x29, 0x51,
+	0xca, 0x19, 0x06, 0x3f, 0x60, 0x60, 0xe5, 0x19, 0x2c, 0x08, 0x5b, 0x30, 0xfb, 0xbe, 0x07, 0xad,
+	0x41, 0x08, 0xec, 0x48, 0xdd, 0xea, 0x66, 0xfb, 0xfe, 0xc2, 0x56, 0xf2, 0xa2, 0x8f, 0x3c, 0x22,
+	0xc6, 0x53, 0x7e, 0x27, 0xc1, 0x5c, 0x70, 0x8f, 0x3c, 0xb2, 0x4c, 0x6c, 0xfb, 0xe7, 0xb9, 0xe7,
+	0xe7, 0xa1, 0x8e, 0xfb, 0xba, 0x69, 0x31, 0x2f, 0x0c, 0x3e, 0x08, 0x74, 0x70, 0x42, 0x74, 0x52,
+	0x0b, 0xa0, 0xf4, 0x03, 0x29, 0x30, 0x45, 0x02, 0x4a, 0x73, 0x8e, 0xb5, 0x23, 0xd3, 0xf5, 0x4f,
+	0xa8, 0x2d, 0x5a, 0x6a, 0x9b, 0x00, 0x3f, 0x3c, 0xde, 0x25, 0x20, 0xb4, 0x06, 0x6d, 0xfc, 0xda,
+	0xc7, 0xae, 0xad, 0x5b, 0x9a, 0x69, 0x50, 0xd5, 0xb7, 0x54, 0x08, 0x41, 0x07, 0x86, 0x72, 0x17,
+	0xe6, 0x79, 0x5e, 0x4b, 0xa3, 0x52, 0x81, 0xd9, 0xc7, 0xd8, 0x2f, 0x14, 0x4d, 0xf9, 0xad, 0x04,
+	0x97, 0x12, 0x48, 0xe7, 0xb8, 0xed, 0xde, 0xba, 0x02, 0x88, 0xb5, 0x82, 0xcc, 0xf4, 0xdf, 0x61,
+	0x2d, 0x9e, 0xd7, 0x52, 0x6b, 0xfd, 0x55, 0x82, 0xc5, 0x43, 0xec, 0xef, 0x9c, 0xea, 0xa6, 0xa5,
+	0x1f, 0x99, 0x96, 0xe9, 0x8f, 0x42, 0x09, 0xd7, 0xa0, 0x1d, 0x3a, 0xad, 0x16, 0x89, 0x0a, 0x21,
+	0xe8, 0xc0, 0x40, 0xef, 0x03, 0xd0, 0x70, 0x24, 0x39, 0x84, 0xe4, 0xad, 0xb4, 0xfb, 0x93, 0xe0,
+	0x3d, 0xb4, 0x1c, 0x5f, 0x6d, 0xf9, 0xec, 0x97, 0x87, 0xd6, 0x61, 0xd2, 0x39, 0xc5, 0xae, 0xa5,
+	0x0f, 0xb4, 0xbe, 0x63, 0x60, 0xa6, 0x9b, 0x36, 0x83, 0x3d, 0x77, 0x0c, 0x8c, 0xae, 0xc3, 0xf4,
+	0x40, 0x77, 0x7d, 0x53, 0xb7, 0x34, 0xbd, 0xd7, 0xc3, 0x03, 0x9f, 0xaa, 0xaa, 0xa9, 0x4e, 0x31,
+	0xe8, 0x0e, 0x05, 0xa2, 0x0d, 0x98, 0x31, 0x0d, 0xdc, 0x1f, 0x38, 0x3e, 0xb6, 0x7b, 0x23, 0xed,
+	0x15, 0x1e, 0x31, 0xa5, 0x4d, 0x27, 0xc0, 0x5f, 0xc3, 0x23, 0xe5, 0x57, 0x12, 0x5c, 0x4e, 0x09,
+	0x59, 0x9a, 0xa2, 0x1f, 0x42, 0xc3, 0xc5, 0xde, 0xd0, 0x8a, 0x64, 0x5b, 0xe3, 0x64, 0x13, 0x76,
+	0x1b, 0x5a, 0xbe, 0x1a, 0xe2, 0xa3, 0xfb, 0x30, 0x81, 0x5d, 0xd7, 0x71, 0x49, 0x9a, 0x25, 0x94,
+	0x72, 0xa6, 0x56, 0xf6, 0x09, 0x8a, 0xca, 0x30, 0x95, 0x6f, 0xc2, 0x14, 0xb7, 0x40, 0xfc, 0xc4,
+	0xb4, 0x0d, 0xfc, 0x9a, 0xf2, 0x55, 0x57, 0x83, 0x0f, 0x24, 0x43, 0x53, 0x77, 0x5f, 0x0e, 0xfb,
+	0xd8, 0xf6, 0x99, 0xaf, 0x45, 0xdf, 0x49, 0x59, 0xaa, 0xbc, 0x99, 0x7f, 0x22, 0x01, 0x4a, 0x33,
+	0x9c, 0x73, 0x44, 0x07, 0x1a, 0xce, 0xd0, 0xef, 0x39, 0x91, 0x37, 0x87, 0x9f, 0x68, 0x1b, 0x1a,
+	0x67, 0xa6, 0x6d, 0x38, 0x67, 0xa1, 0x60, 0x39, 0xe6, 0x0e, 0xb1, 0x12, 0xd7, 0x66, 0x8d, 0xbb,
+	0x36, 0xff, 0x26, 0xc1, 0x52, 0xe0, 0xa9, 0x17, 0xf2, 0xbc, 0x15, 0x00, 0xcf, 0xd7, 0x5d, 0x9f,
+	0x5e, 0x1c, 0x8c, 0xc9, 0x16, 0x85, 0x10, 0x16, 0xd0, 0x12, 0x34, 0xb1, 0x1d, 0xdc, 0x2a, 0xa1,
+	0x22, 0xb0, 0x4d, 0x6f, 0x12, 0x74, 0x0d, 0xa6, 0x6d, 0x7c, 0xa6, 0x25, 0xa8, 0x03, 0xc6, 0x26,
+	0x6d, 0x7c, 0x76, 0x18, 0x6d, 0xd0, 0x05, 0xf2, 0xad, 0x45, 0x9b, 0x04, 0x6e, 0x05, 0x36, 0x3e,
+	0xdb, 0x67, 0xfb, 0xcc, 0x43, 0xfd, 0xd8, 0x71, 0x7b, 0x98, 0x06, 0x61, 0x53, 0x0d, 0x3e, 0x12,
+	0xe2, 0x36, 0x38, 0x71, 0x7f, 0x2a, 0x81, 0x9c, 0x25, 0x6e, 0xa9, 0x0f, 0x7e, 0x5c, 0x50, 0x26,
+	0xa4, 0x5d, 0x32, 0x51, 0x2a, 0xec, 0x61, 0x5f, 0x37, 0x2d, 0x2f, 0xaf, 0x8e, 0xf8, 0x8d, 0x04,
+	0x4b, 0x2a, 0xee, 0x3b, 0xa7, 0x6f, 0x5b, 0xff, 0x91, 0xde, 0x6a, 0xd9, 0x7a, 0xab, 0xa7, 0xf4,
+	0x96, 0xc5, 0xe6, 0x7f, 0x4c, 0x6f, 0xff, 0x90, 0x60, 0x96, 0x63, 0x65, 0x68, 0xa5, 0xef, 0x2d,
+	0x41, 0x7d, 0x95, 0x94, 0xfa, 0x64, 0x68, 0x9e, 0x61, 0xfc, 0xca, 0xd0, 0x47, 0x41, 0x1c, 0xb5,
+	0xd4, 0xe8, 0x5b, 0x50, 0x6d, 0xad, 0x48, 0xb5, 0x75, 0x5e, 0xb5, 0x11, 0x25, 0xf1, 0xb3, 0xce,
+	0x44, 0x82, 0x72, 0x4f, 0xf7, 0x23, 0x4a, 0xba, 0xd8, 0x88, 0x28, 0xe9, 0xd2, 0x2a, 0x00, 0x7e,
+	0x4d, 0x52, 0x2a, 0xd5, 0x50, 0x93, 0x72, 0x94, 0x80, 0x28, 0x7f, 0x97, 0x60, 0x25, 0xa8, 0x02,
+	0x44, 0xd9, 0xc7, 0xf6, 0x98, 0xa4, 0xc8, 0x95, 0x42, 0x91, 0xab, 0x45, 0x22, 0xd7, 0x8a, 0x44,
+	0xae, 0x17, 0x89, 0x3c, 0x51, 0x24, 0x72, 0x23, 0x25, 0xf2, 0x53, 0x58, 0xcd, 0x93, 0x38, 0xa7,
+	0x5a, 0x49, 0x38, 0x63, 0x85, 0x4f, 0xbe, 0x5f, 0x86, 0x65, 0x52, 0x3e, 0x8a, 0x3b, 0x79, 0xe3,
+	0x2a, 0x4f, 0x79, 0x01, 0x2b, 0x39, 0x1b, 0x44, 0x75, 0x68, 0xdd, 0x25, 0x00, 0x56, 0x83, 0xae,
+	0xe4, 0x5f, 0x54, 0x44, 0x82, 0x00, 0x57, 0xd9, 0x86, 0x95, 0x3d, 0x6c, 0xe1, 0x7c, 0xa3, 0x8a,
+	0x55, 0xdb, 0xff, 0xc3, 0x6a, 0x1e, 0x41, 0x69, 0x9d, 0xa1, 0xc2, 0xd5, 0x1d, 0xc3, 0x10, 0x09,
+	0xf7, 0x43, 0x85, 0x87, 0x47, 0x5e, 0x86, 0x06, 0x61, 0x2e, 0x56, 0xc3, 0x04, 0xf9, 0x3c, 0xa0,
+	0xe5, 0x15, 0x35, 0x23, 0x2b, 0xaf, 0xc8, 0x6f, 0xc5, 0x84, 0x6b, 0xc5, 0x7b, 0x96, 0xa6, 0x89,
+	0x0d, 0x98, 0x49, 0x68, 0x4a, 0x33, 0x8d, 0xd0, 0x39, 0xa7, 0x13, 0xe0, 0x03, 0xc3, 0x53, 0xfe,
+	0x28, 0xc1, 0xcc, 0xce, 0x60, 0xe0, 0x98, 0xb6, 0x4f, 0x6e, 0xda, 0x17, 0xa3, 0xc1, 0x05, 0xc2,
+	0x3e, 0x2c, 0x11, 0xab, 0x89, 0x12, 0xf1, 0x26, 0xcc, 0xa6, 0x5a, 0xb3, 0xa0, 0xcd, 0x9a, 0x31,
+	0x84, 0xb6, 0x6c, 0x1e, 0xea, 0x3d, 0xc7, 0x72, 0x5c, 0xe6, 0xe7, 0xc1, 0x07, 0xea, 0x42, 0xdb,
+	0xc0, 0x5e, 0xcf, 0x35, 0xa9, 0xcc, 0xcc, 0xcd, 0x93, 0x20, 0xe5, 0x0f, 0x12, 0x2c, 0x33, 0x5f,
+	0xe6, 0x25, 0x18, 0x3b, 0x78, 0xb3, 0x6a, 0xdb, 0x2c, 0xc6, 0xab, 0x25, 0x8c, 0xd7, 0x0a, 0x18,
+	0xaf, 0xa7, 0x19, 0x3f, 0x80, 0x95, 0x1c, 0xbe, 0xcf, 0x1d, 0x82, 0x5f, 0x82, 0x2b, 0x34, 0x82,
+	0xf8, 0x8d, 0xc6, 0x8f, 0x40, 0x13, 0x96, 0xb3, 0xe9, 0x19, 0x27, 0x07, 0x70, 0x49, 0x8f, 0xd7,
+	0x34, 0x7f, 0x34, 0x88, 0x82, 0x71, 0x99, 0x0f, 0x46, 0x41, 0x94, 0x59, 0x5d, 0xd8, 0x52, 0xd9,
+	0x82, 0x65, 0x16, 0x65, 0xd9, 0xd6, 0x12, 0xa3, 0xf2, 0x21, 0xac, 0xe4, 0xe0, 0x97, 0x06, 0xe5,
+	0xcf, 0x24, 0xe8, 0x3c, 0x8e, 0xea, 0x62, 0x2b, 0x28, 0xd0, 0xcf, 0xe3, 0x15, 0x62, 0x48, 0xa2,
+	0x2d, 0x98, 0x13, 0xf5, 0x40, 0x88, 0x03, 0x8f, 0xbf, 0x24, 0xc8, 0x7a, 0x60, 0xf0, 0x1d, 0x7d,
+	0x8d, 0xef, 0xe8, 0x95, 0x27, 0xb0, 0x94, 0xc1, 0x1d, 0x93, 0xea, 0x36, 0xd4, 0x83, 0xbe, 0x43,
+	0x2a, 0x2a, 0x44, 0x03, 0x1c, 0xe5, 0xd7, 0x12, 0xa0, 0xe0, 0x1e, 0x0f, 0xc0, 0x71, 0xb6, 0x21,
+	0xeb, 0x89, 0x6c, 0x43, 0x3e, 0x03, 0xb6, 0x7a, 0xb4, 0x83, 0x8a, 0x03, 0xb9, 0x19, 0x00, 0x0e,
+	0x8c, 0x73, 0xcb, 0x98, 0xd1, 0xa6, 0xd4, 0x32, 0xdb, 0x94, 0x9f, 0x4b, 0x30, 0xc7, 0x71, 0xc9,
+	0x44, 0xbd, 0x0e, 0xd3, 0x7c, 0x96, 0x62, 0xdc, 0x4e, 0x71, 0x49, 0x2a, 0xdf, 0xfb, 0xc9, 0x35,
+	0x79, 0xe2, 0x58, 0x86, 0xe6, 0x3b, 0xaf, 0x70, 0x38, 0xc0, 0x6a, 0x11, 0xc8, 0x0b, 0x02, 0x40,
+	0x37, 0x60, 0x86, 0x2e, 0xe3, 0xd7, 0x03, 0xd3, 0xc5, 0x9e, 0xa6, 0xfb, 0x8c, 0xc1, 0x29, 0x02,
+	0xde, 0x0f, 0xa0, 0x3b, 0xbe, 0xa2, 0xc3, 0xd2, 0x23, 0xc7, 0x3e, 0x36, 0xdd, 0x7e, 0xa2, 0x26,
+	0x0a, 0x75, 0x39, 0x26, 0x93, 0x3c, 0x2b, 0x15, 0x81, 0x15, 0xe5, 0x03, 0x90, 0xb3, 0x8e, 0x28,
+	0xf5, 0xe4, 0x4f, 0xe0, 0xd2, 0xfe, 0x6b, 0x1f, 0xdb, 0xc6, 0x13, 0xc7, 0x32, 0xde, 0x2c, 0x4b,
+	0x3f, 0x96, 0x00, 0x25, 0xf7, 0x2e, 0xbd, 0x54, 0x32, 0xd4, 0x59, 0xc9, 0x50, 0x27, 0xba, 0x07,
+	0xf3, 0xa4, 0x75, 0xb7, 0x3d, 0x52, 0x70, 0x68, 0x2e, 0xee, 0xeb, 0xa6, 0x6d, 0xda, 0x2f, 0xc3,
+	0xc9, 0x5c, 0xbc, 0xa6, 0x86, 0x4b, 0xca, 0xf7, 0x25, 0xe8, 0x3c, 0xa2, 0x85, 0xe9, 0xc5, 0x2d,
+	0x70, 0x1d, 0x26, 0xe3, 0xd2, 0xf8, 0x68, 0x14, 0xf0, 0xb6, 0x5b, 0xe9, 0x48, 0x6a, 0x3b, 0x82,
+	0xef, 0x8e, 0x72, 0x07, 0x9e, 0x0f, 0x60, 0x29, 0x83, 0x83, 0x52, 0x03, 0x61, 0x58, 0x56, 0xb1,
+	0xd7, 0x3b, 0xc1, 0x46, 0x50, 0x2f, 0x5c, 0x90, 0xf9, 0x55, 0x68, 0xd3, 0xf6, 0x8d, 0x45, 0x2d,
+	0x33, 0x16, 0xe9, 0xdd, 0x68, 0xe0, 0x2a, 0xdf, 0x81, 0x95, 0x9c, 0x63, 0xde, 0x50, 0x2c, 0x29,
+	0x16, 0x34, 0xc3, 0xec, 0x92, 0xba, 0x7f, 0x2e, 0xde, 0x16, 0x2d, 0xc2, 0x84, 0xe7, 0xeb, 0xfe,
+	0xd0, 0x0b, 0xfb, 0xe4, 0xe0, 0x4b, 0x19, 0xc2, 0xfa, 0x63, 0xec, 0x07, 0x82, 0x60, 0x83, 0x1c,
+	0xea, 0xed, 0x8e, 0xc4, 0x49, 0xf7, 0x85, 0x32, 0x35, 0x97, 0x79, 0xab, 0x42, 0xe6, 0x35, 0x41,
+	0x29, 0x3a, 0x96, 0xe9, 0xf2, 0x11, 0x4c, 0x72, 0xad, 0x95, 0x34, 0x5e, 0x6b, 0xc5, 0x11, 0x29,
+	0x0e, 0xac, 0xa5, 0x8f, 0xe2, 0x47, 0x6d, 0x5c, 0x36, 0x96, 0x84, 0x6c, 0x7c, 0x6e, 0xd9, 0x5e,
+	0x42, 0x37, 0xff, 0xc0, 0x37, 0x29, 0xd9, 0x3f, 0xa3, 0x4b, 0x27, 0x89, 0x34, 0xae, 0x07, 0x16,
+	0x5e, 0x41, 0x82, 0xc5, 0xab, 0x29, 0x8b, 0xe7, 0xf8, 0x93, 0xe0, 0xa1, 0xf5, 0x22, 0x0f, 0x9d,
+	0xe0, 0x3d, 0x34, 0xe7, 0xd6, 0x6b, 0xe4, 0xdc, 0x7a, 0xca, 0x2f, 0x2a, 0x30, 0xf7, 0xd4, 0x31,
+	0xed, 0x6f, 0xe8, 0xa6, 0x6f, 0x99, 0xde, 0x78, 0xc6, 0x2c, 0x2d, 0xa1, 0xf9, 0x86, 0xaf, 0x5a,
+	0xd4, 0xf0, 0xd5, 0xf8, 0x86, 0x2f, 0x87, 0xff, 0x7a, 0xde, 0xad, 0x9d, 0x6c, 0x58, 0x27, 0x84,
+	0x86, 0xf5, 0x2a, 0x4c, 0x61, 0xdd, 0xb5, 0x4c, 0xec, 0x31, 0x45, 0x06, 0x5a, 0x98, 0x0c, 0x81,
+	0x54, 0x61, 0x6b, 0xd0, 0xb6, 0x74, 0x3f, 0x42, 0x69, 0x06, 0xb2, 0x04, 0x20, 0x82, 0xa0, 0x7c,
+	0x05, 0xe6, 0x79, 0x05, 0x9d, 0xbb, 0xaa, 0x7d, 0x08, 0xf2, 0x63, 0xec, 0x87, 0x1b, 0x9c, 0x27,
+	0x6c, 0x94, 0x43, 0xb8, 0x92, 0x49, 0xca, 0x78, 0x78, 0x1f, 0x1a, 0xd8, 0xf6, 0x5d, 0x33, 0xaa,
+	0x62, 0xf9, 0x09, 0x66, 0x48, 0xb7, 0x6f, 0xfb, 0xee, 0x48, 0x0d, 0x51, 0x95, 0x3f, 0x57, 0x61,
+	0x8a, 0x5b, 0x4a, 0xc9, 0xf2, 0xef, 0x79, 0x35, 0x6f, 0xfd, 0x5a, 0x91, 0xf5, 0xeb, 0x63, 0x59,
+	0x7f, 0x62, 0x1c, 0xeb, 0x37, 0xca, 0xac, 0xdf, 0x2c, 0xb7, 0x7e, 0x4b, 0xb4, 0x7e, 0x22, 0x42,
+	0x81, 0x8b, 0xd0, 0x74, 0x7a, 0x68, 0x67, 0xa5, 0x87, 0xdb, 0x50, 0x77, 0xc8, 0x5f, 0x6a, 0x9d,
+	0xc9, 0xae, 0x54, 0x50, 0xfe, 0x52, 0x1c, 0xb4, 0x09, 0xb3, 0xf4, 0x47, 0xb2, 0x24, 0x99, 0x0a,
+	0x4a, 0x50, 0x0a, 0x8f, 0x4b, 0xbc, 0x77, 0x41, 0x0e, 0x86, 0xeb, 0xa1, 0x19, 0x3f, 0x24, 0xeb,
+	0x79, 0xad, 0xc7, 0xb7, 0xe1, 0x4a, 0x26, 0xf6, 0x1b, 0xba, 0x6b, 0xef, 0xff, 0xe8, 0x32, 0x97,
+	0x41, 0x0f, 0xb1, 0x7b, 0x6a, 0xf6, 0x30, 0xfa, 0x16, 0xcc, 0x08, 0xd3, 0x7c, 0x74, 0x95, 0x93,
+	0x3f, 0xfb, 0x0f, 0x0d, 0xf9, 0x5a, 0x31, 0x12, 0xe3, 0x1a, 0x03, 0x4a, 0x8f, 0x6a, 0xd1, 0x0d,
+	0x8e, 0x36, 0x77, 0x74, 0x2d, 0x6f, 0x94, 0xe2, 0xc5, 0xc7, 0xa4, 0x27, 0x9b, 0xc2, 0x31, 0xb9,
+	0x13, 0x5a, 0x79, 0xa3, 0x14, 0x8f, 0x1d, 0xf3, 0x59, 0xf8, 0x66, 0x25, 0x35, 0xb5, 0xbc, 0xc5,
+	0x6d, 0x51, 0x38, 0xde, 0x93, 0x6f, 0x8f, 0x85, 0xcb, 0x8e, 0xb4, 0x83, 0x7f, 0x4b, 0xc5, 0x75,
+	0x0f, 0xdd, 0xe4, 0x76, 0x29, 0x1a, 0x89, 0xc9, 0xb7, 0xc6, 0x41, 0x8d, 0x45, 0xcc, 0x1e, 0x4b,
+	0x09, 0x22, 0x16, 0x0e, 0xbb, 0xe4, 0xdb, 0x63, 0xe1, 0xb2, 0x23, 0x7f, 0x28, 0xc1, 0x72, 0xd1,
+	0xe8, 0x09, 0xdd, 0xe5, 0x9b, 0xfe, 0xf2, 0xc9, 0x97, 0x7c, 0xef, 0x1c, 0x14, 0xb1, 0xa2, 0x33,
+	0xe7, 0x23, 0x82, 0xa2, 0x8b, 0x66, 0x3f, 0xf2, 0xad, 0x71, 0x50, 0xd9, 0x79, 0xaf, 0x82, 0x7f,
+	0xd2, 0x85, 0x65, 0x0f, 0x6d, 0xa6, 0x8d, 0x95, 0x3d, 0x67, 0x91, 0x6f, 0x8e, 0x81, 0x19, 0x0b,
+	0x97, 0x39, 0xd6, 0x10, 0x84, 0x2b, 0x1a, 0x95, 0xc8, 0xb7, 0xc6, 0x41, 0x65, 0xe7, 0x1d, 0xd1,
+	0x7f, 0xa4, 0xf9, 0x61, 0x03, 0xba, 0xce, 0x6d, 0x90, 0x37, 0x2a, 0x91, 0x6f, 0x94, 0xa1, 0xb1,
+	0x33, 0x3e, 0x82, 0x76, 0xa2, 0xbf, 0x47, 0x59, 0xf5, 0x64, 0x72, 0x3e, 0x21, 0x77, 0xf3, 0x11,
+	0xe2, 0x2c, 0x92, 0xee, 0x97, 0x85, 0x2c, 0x92, 0xdb, 0xb3, 0xcb, 0x1b, 0xa5, 0x78, 0xec, 0x98,
+	0xe7, 0x00, 0x71, 0x0b, 0x8c, 0x56, 0x39, 0xb2, 0x54, 0xdf, 0x2d, 0xaf, 0xe5, 0xae, 0xc7, 0xba,
+	0x4e, 0xf5, 0x90, 0x82, 0xae, 0xf3, 0xba, 0x5c, 0xf9, 0x46, 0x19, 0x5a, 0xec, 0x3f, 0x99, 0x9d,
+	0xa0, 0xe0, 0x3f, 0x45, 0x4d, 0xa9, 0x7c, 0x6b, 0x1c, 0x54, 0x76, 0xde, 0x27, 0x30, 0xcd, 0x3f,
+	0x0e, 0x44, 0x4a, 0x46, 0x68, 0x09, 0xad, 0x9b, 0x7c, 0xb5, 0x10, 0x27, 0x76, 0x9b, 0xc4, 0xe3,
+	0x40, 0xc1, 0x6d, 0xd2, 0xaf, 0x0b, 0xe5, 0x6e, 0x3e, 0x42, 0xcc, 0x2c, 0xff, 0xda, 0x4a, 0x60,
+	0x36, 0xf3, 0x35, 0x9d, 0x7c, 0xb5, 0x10, 0x27, 0xf6, 0xc8, 0xf4, 0x83, 0x28, 0xc1, 0x23, 0x73,
+	0x1f, 0x6d, 0xc9, 0x1b, 0xa5, 0x78, 0xec, 0x98, 0x8f, 0x61, 0x8a, 0x7b, 0x92, 0x83, 0xd6, 0x53,
+	0xa9, 0x45, 0x7c, 0xf1, 0x23, 0x2b, 0x45, 0x28, 0x6c, 0xdf, 0x43, 0x98, 0x4c, 0xbe, 0x77, 0x41,
+	0xdd, 0x0c, 0x03, 0x71, 0x65, 0xb6, 0xbc, 0x5e, 0x80, 0xc1, 0x36, 0x7d, 0x0a, 0xad, 0xe8, 0xb5,
+	0x0b, 0x5a, 0x11, 0xad, 0xc3, 0x6f, 0xb7, 0x9a, 0xb7, 0x1c, 0x33, 0x98, 0x7c, 0xe2, 0x21, 0x30,
+	0x98, 0xf1, 0x52, 0x45, 0x5e, 0x2f, 0xc0, 0x60, 0x9b, 0x7e, 0x8f, 0x36, 0x12, 0x39, 0xfd, 0x3e,
+	0xda, 0x12, 0x59, 0x2a, 0x9e, 0x47, 0xc8, 0xdb, 0x63, 0xe3, 0xb3, 0xe3, 0xcf, 0xa0, 0x93, 0xc6,
+	0x62, 0xf2, 0xbd, 0x5b, 0xb2, 0x19, 0x2f, 0xeb, 0x9d, 0x31, 0xb1, 0x63, 0x65, 0x26, 0x5b, 0x30,
+	0x41, 0x99, 0x19, 0xed, 0xab, 0xbc, 0x5e, 0x80, 0xc1, 0x36, 0x3d, 0xa1, 0x6f, 0x79, 0xc5, 0xd6,
+	0x0a, 0x6d, 0x88, 0xac, 0xe5, 0xf4, 0x6d, 0xf2, 0x66, 0x39, 0x62, 0x7c, 0x52, 0x46, 0xfd, 0x2d,
+	0x9c, 0x94, 0x5f, 0xcf, 0xcb, 0x9b, 0xe5, 0x88, 0xc1, 0x49, 0xbb, 0x7b, 0x9f, 0xee, 0xbe, 0x34,
+	0xfd, 0x93, 0xe1, 0xd1, 0x56, 0xcf, 0xe9, 0x6f, 0xf7, 0x75, 0x7b, 0x88, 0x2d, 0x03, 0x5b, 0x2e,
+	0xd6, 0xad, 0xed, 0x13, 0xac, 0x5b, 0xfe, 0xc9, 0x9d, 0xc4, 0x56, 0x77, 0xbc, 0x91, 0xe7, 0xe3,
+	0xfe, 0xb6, 0x3e, 0x30, 0xbf, 0x90, 0x00, 0x1f, 0x4d, 0xd0, 0x77, 0xef, 0xef, 0xfd, 0x6b, 0x00,
+	0x9f, 0x0d, 0xb0, 0x3a, 0x10, 0x2f, 0x00, 0x00,
 }
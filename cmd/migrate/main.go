@@ -0,0 +1,123 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/manueldelreal/health-reservation-system/internal/config"
+	"github.com/manueldelreal/health-reservation-system/internal/migrations"
+	"github.com/manueldelreal/health-reservation-system/internal/storage"
+)
+
+const usage = `Usage: migrate [-config FILE] [-dsn DSN] <command>
+
+Commands:
+  up        Apply all pending migrations
+  down [N]  Revert the last N applied migrations (default 1)
+  status    List migrations and whether they are applied
+  check     Verify that the database schema matches the models
+
+The DSN is read like the server reads it: -dsn, then $DATABASE_DSN, then the
+config file given by -config or $CONFIG_FILE, then the default SQLite database.
+`
+
+func main() {
+	configFile := flag.String("config", os.Getenv("CONFIG_FILE"), "YAML or TOML config file")
+	dsn := flag.String("dsn", "", "database DSN, SQLite file or PostgreSQL")
+	flag.Usage = func() { fmt.Fprint(flag.CommandLine.Output(), usage) }
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// Use the same database as the server
+	cfg := config.Default()
+	if *configFile != "" {
+		if err := cfg.LoadFile(*configFile); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := cfg.LoadEnv(); err != nil {
+		log.Fatal(err)
+	}
+	if *dsn != "" {
+		cfg.DatabaseDSN = *dsn
+	}
+
+	db, err := storage.OpenDatabase(cfg.DatabaseDSN)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+
+	switch flag.Arg(0) {
+	case "up":
+		applied, err := migrations.Up(db)
+		for _, migration := range applied {
+			fmt.Printf("Applied %04d_%s\n", migration.Version, migration.Name)
+		}
+		if err != nil {
+			log.Fatalf("Failed to migrate database: %v", err)
+		}
+		if len(applied) == 0 {
+			fmt.Println("Database is up to date")
+		}
+
+	case "down":
+		steps := 1
+		if flag.NArg() > 1 {
+			steps, err = strconv.Atoi(flag.Arg(1))
+			if err != nil || steps < 1 {
+				log.Fatalf("Invalid number of migrations: %s", flag.Arg(1))
+			}
+		}
+		reverted, err := migrations.Down(db, steps)
+		for _, migration := range reverted {
+			fmt.Printf("Reverted %04d_%s\n", migration.Version, migration.Name)
+		}
+		if err != nil {
+			log.Fatalf("Failed to revert migration: %v", err)
+		}
+		if len(reverted) == 0 {
+			fmt.Println("No migrations to revert")
+		}
+
+	case "status":
+		all, err := migrations.Load(db.Dialector.Name())
+		if err != nil {
+			log.Fatal(err)
+		}
+		applied, err := migrations.Applied(db)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, migration := range all {
+			state := "pending"
+			if appliedAt, ok := applied[migration.Version]; ok {
+				state = "applied " + appliedAt.UTC().Format("2006-01-02T15:04:05Z")
+			}
+			fmt.Printf("%04d_%s\t%s\n", migration.Version, migration.Name, state)
+		}
+
+	case "check":
+		pending, err := migrations.Pending(db)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(pending) > 0 {
+			log.Fatalf("%d migrations are pending, run migrate up first", len(pending))
+		}
+		if err := storage.CheckSchema(db); err != nil {
+			log.Fatalf("Database schema does not match the models: %v", err)
+		}
+		fmt.Println("Database schema matches the models")
+
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
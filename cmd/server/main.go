@@ -1,40 +1,213 @@
 package main
 
 import (
-	"log"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
+	_ "time/tzdata" // Embed the IANA time zone database for provider time zones
 
+	"github.com/twitchtv/twirp"
+
+	"github.com/manueldelreal/health-reservation-system/internal/auth"
+	"github.com/manueldelreal/health-reservation-system/internal/config"
+	"github.com/manueldelreal/health-reservation-system/internal/health"
+	"github.com/manueldelreal/health-reservation-system/internal/idempotency"
+	"github.com/manueldelreal/health-reservation-system/internal/logging"
+	"github.com/manueldelreal/health-reservation-system/internal/metrics"
 	"github.com/manueldelreal/health-reservation-system/internal/services"
 	"github.com/manueldelreal/health-reservation-system/internal/storage"
+	"github.com/manueldelreal/health-reservation-system/internal/telemetry"
+	"github.com/manueldelreal/health-reservation-system/internal/worker"
 
 	pb "github.com/manueldelreal/health-reservation-system/api"
 )
 
+// cleanupJob is the name of the job that releases expired holds.
+const cleanupJob = "cleanup-expired-reservations"
+
+// readinessTimeout bounds the readiness checks of a single probe.
+const readinessTimeout = 2 * time.Second
+
+// cleanupStaleness is how many cleanup intervals may pass without a successful
+// run before the server reports itself not ready.
+const cleanupStaleness = 3
+
 func main() {
-	// Connect to SQLite database
-	dsn := "file:health_reservation.db?cache=shared&mode=rwc"
-	storage.ConnectDatabase(dsn)
+	// Log JSON lines to stdout, at the configured level once it is known
+	var logLevel slog.LevelVar
+	slog.SetDefault(logging.New(os.Stdout, &logLevel))
 
-	// Start cleanup task for expired reservations
-	go func() {
-		for {
-			err := storage.CleanupExpiredReservations()
-			if err != nil {
-				log.Printf("Failed to clean expired reservations: %v", err)
-			}
-			time.Sleep(1 * time.Minute) // Run every minute
+	// Load the configuration from flags, environment variables and file
+	cfg, err := config.Load(os.Args[1:])
+	if err != nil {
+		slog.Error("Failed to load configuration", "error", err)
+		os.Exit(1)
+	}
+	level, err := logging.ParseLevel(cfg.LogLevel)
+	if err != nil {
+		slog.Error("Failed to load configuration", "error", err)
+		os.Exit(1)
+	}
+	logLevel.Set(level)
+
+	// Stop on SIGINT or SIGTERM
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Export traces of requests, queries and background jobs
+	shutdownTracing, err := telemetry.Setup(ctx, telemetry.Options{
+		Exporter: cfg.TracingExporter,
+		File:     cfg.TracingFile,
+		Endpoint: cfg.TracingEndpoint,
+	})
+	if err != nil {
+		slog.Error("Failed to set up tracing", "error", err)
+		os.Exit(1)
+	}
+
+	// Connect to the database, SQLite unless the DSN points at PostgreSQL
+	repo := storage.ConnectDatabase(cfg.DatabaseDSN)
+
+	// Collect metrics, counting Available slots from the database on scrape
+	serviceMetrics := metrics.New(repo.CountAvailableSlots)
+
+	// Initialize the reservation service
+	server := &services.ReservationService{
+		Repo:                   repo,
+		Metrics:                serviceMetrics,
+		HoldExpiry:             cfg.HoldExpiry,
+		MaxHoldExtensions:      cfg.MaxHoldExtensions,
+		WaitlistOfferExpiry:    cfg.WaitlistOfferExpiry,
+		MinLeadTime:            cfg.MinLeadTime,
+		CancellationCutoff:     cfg.CancellationCutoff,
+		MaterializationHorizon: cfg.MaterializationHorizon,
+		IdempotencyTTL:         cfg.IdempotencyTTL,
+	}
+
+	// Start the background jobs: releasing expired holds and offering their
+	// slots to the waitlist, materializing recurring availability rules into
+	// slots and forgetting expired idempotency keys
+	workers := worker.NewSupervisor()
+	workers.Add(worker.Job{
+		Name:     cleanupJob,
+		Interval: cfg.CleanupInterval,
+		Run: func(ctx context.Context) error {
+			return server.CleanupExpiredReservations(ctx)
+		},
+	})
+	workers.Add(worker.Job{
+		Name:     "materialize-availability-rules",
+		Interval: cfg.MaterializationInterval,
+		Run: func(ctx context.Context) error {
+			return server.MaterializeAvailabilityRules(ctx)
+		},
+	})
+	workers.Add(worker.Job{
+		Name:     "delete-expired-idempotency-keys",
+		Interval: cfg.CleanupInterval,
+		Run: func(ctx context.Context) error {
+			_, err := repo.DeleteExpiredIdempotencyKeys(ctx)
+			return err
+		},
+	})
+	workers.Start(ctx)
+
+	// Authenticate API requests with bearer tokens, unless authentication was
+	// explicitly disabled
+	authenticator := auth.NewDisabledAuthenticator()
+	if cfg.AuthDisabled {
+		slog.Warn("Authentication is disabled, every request is treated as an admin's; set API_KEYS or JWT_SECRET instead of -auth-disabled to enable it")
+	} else {
+		authenticator, err = auth.NewAuthenticator(cfg.APIKeys, cfg.JWTSecret)
+		if err != nil {
+			slog.Error("Failed to set up authentication", "error", err)
+			os.Exit(1)
 		}
-	}()
+	}
 
-	// Initialize the Twirp server
-	server := &services.ReservationService{}
-	twirpHandler := pb.NewReservationServiceServer(server)
+	// Initialize the Twirp server, tracing, measuring and logging every request
+	twirpHandler := pb.NewReservationServiceServer(server,
+		twirp.WithServerHooks(twirp.ChainHooks(
+			telemetry.ServerHooks(),
+			serviceMetrics.ServerHooks(),
+			logging.ServerHooks(),
+		)),
+		twirp.WithServerInterceptors(logging.Interceptor()),
+	)
 
 	mux := http.NewServeMux()
-	mux.Handle(twirpHandler.PathPrefix(), twirpHandler)
+	mux.Handle(twirpHandler.PathPrefix(), telemetry.Middleware(authenticator.Middleware(idempotency.Middleware(twirpHandler))))
+
+	// Prometheus metrics and health endpoints for the orchestrator. The server
+	// is ready when the database answers, the schema is migrated and expired
+	// holds are being released
+	mux.Handle("/metrics", serviceMetrics.Handler())
+	mux.Handle("/healthz", health.LivenessHandler())
+	mux.Handle("/readyz", health.ReadinessHandler(readinessTimeout,
+		health.Check{Name: "database", Run: repo.Ping},
+		health.Check{Name: "migrations", Run: repo.CheckMigrations},
+		health.Check{Name: cleanupJob, Run: func(ctx context.Context) error {
+			return checkRecentRun(workers.LastSuccess(cleanupJob), cleanupStaleness*cfg.CleanupInterval)
+		}},
+	))
 
 	// Start the server
-	log.Println("Starting server on :8080")
-	log.Fatal(http.ListenAndServe(":8080", mux))
+	httpServer := &http.Server{Addr: cfg.Addr(), Handler: mux}
+	serverErr := make(chan error, 1)
+	go func() {
+		slog.Info("Starting server", "addr", cfg.Addr())
+		serverErr <- httpServer.ListenAndServe()
+	}()
+
+	// Wait for a signal, or for the server to fail
+	exitCode := 0
+	select {
+	case <-ctx.Done():
+		slog.Info("Shutting down")
+	case err := <-serverErr:
+		slog.Error("Server failed", "error", err)
+		exitCode = 1
+	}
+	// A second signal kills the process right away
+	stop()
+
+	// Drain in-flight requests, then let running jobs finish their transactions
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		slog.Error("Failed to drain requests", "error", err)
+		exitCode = 1
+	}
+	if err := workers.Stop(shutdownCtx); err != nil {
+		slog.Error("Failed to stop background jobs", "error", err)
+		exitCode = 1
+	}
+	if err := repo.Close(); err != nil {
+		slog.Error("Failed to close database", "error", err)
+		exitCode = 1
+	}
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		slog.Error("Failed to flush traces", "error", err)
+		exitCode = 1
+	}
+
+	slog.Info("Server stopped")
+	os.Exit(exitCode)
+}
+
+// checkRecentRun fails if a job has not succeeded within maxAge.
+func checkRecentRun(lastSuccess time.Time, maxAge time.Duration) error {
+	if lastSuccess.IsZero() {
+		return errors.New("has not run yet")
+	}
+	if age := time.Since(lastSuccess); age > maxAge {
+		return fmt.Errorf("last succeeded %s ago", age.Round(time.Second))
+	}
+	return nil
 }
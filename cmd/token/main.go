@@ -0,0 +1,59 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/manueldelreal/health-reservation-system/internal/auth"
+	"github.com/manueldelreal/health-reservation-system/internal/config"
+)
+
+const usage = `Usage: token [-config FILE] [-ttl DURATION] <role> [subject]
+
+Prints a JWT bearer token for the given role (admin, provider or client). The
+subject is the provider or client ID and is required unless the role is admin.
+
+The signing secret is read like the server reads it: $JWT_SECRET, then the
+config file given by -config or $CONFIG_FILE.
+`
+
+func main() {
+	configFile := flag.String("config", os.Getenv("CONFIG_FILE"), "YAML or TOML config file")
+	ttl := flag.Duration("ttl", 24*time.Hour, "how long the token is valid")
+	flag.Usage = func() { fmt.Fprint(flag.CommandLine.Output(), usage) }
+	flag.Parse()
+
+	if flag.NArg() < 1 || flag.NArg() > 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// Sign with the same secret as the server
+	cfg := config.Default()
+	if *configFile != "" {
+		if err := cfg.LoadFile(*configFile); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := cfg.LoadEnv(); err != nil {
+		log.Fatal(err)
+	}
+
+	authenticator, err := auth.NewAuthenticator(nil, cfg.JWTSecret)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	role, err := auth.ParseRole(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	token, err := authenticator.IssueToken(auth.Principal{Role: role, Subject: flag.Arg(1)}, *ttl)
+	if err != nil {
+		log.Fatalf("Failed to issue token: %v", err)
+	}
+	fmt.Println(token)
+}
@@ -0,0 +1,80 @@
+// Package auth authenticates API callers from bearer tokens, either static API
+// keys or locally signed JWTs, and carries the resulting principal in the
+// request context.
+package auth
+
+import (
+	"context"
+	"fmt"
+)
+
+// Role is what a principal is allowed to do.
+type Role string
+
+// Roles of a principal.
+const (
+	// RoleAdmin may call every method on any record.
+	RoleAdmin Role = "admin"
+
+	// RoleProvider manages the availability, appointment types and
+	// reservations of the provider named by the subject.
+	RoleProvider Role = "provider"
+
+	// RoleClient books and manages the reservations of the client named by
+	// the subject.
+	RoleClient Role = "client"
+)
+
+// ParseRole reads a role name.
+func ParseRole(name string) (Role, error) {
+	switch role := Role(name); role {
+	case RoleAdmin, RoleProvider, RoleClient:
+		return role, nil
+	default:
+		return "", fmt.Errorf("unknown role %q", name)
+	}
+}
+
+// Principal is an authenticated caller.
+type Principal struct {
+	Role Role
+
+	// Subject is the provider ID of a provider or the client ID of a client.
+	// Admins need none.
+	Subject string
+}
+
+// Validate checks that a principal has a known role and, unless it is an admin,
+// a subject.
+func (p Principal) Validate() error {
+	if _, err := ParseRole(string(p.Role)); err != nil {
+		return err
+	}
+	if p.Role != RoleAdmin && p.Subject == "" {
+		return fmt.Errorf("%s principal needs a subject", p.Role)
+	}
+	return nil
+}
+
+// Provider returns the principal of a provider.
+func Provider(providerID string) Principal {
+	return Principal{Role: RoleProvider, Subject: providerID}
+}
+
+// Client returns the principal of a client.
+func Client(clientID string) Principal {
+	return Principal{Role: RoleClient, Subject: clientID}
+}
+
+type principalKey struct{}
+
+// NewContext returns a context carrying a principal.
+func NewContext(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// FromContext returns the principal of a request, if it was authenticated.
+func FromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+	return principal, ok
+}
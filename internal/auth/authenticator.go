@@ -0,0 +1,164 @@
+package auth
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/twitchtv/twirp"
+
+	"github.com/manueldelreal/health-reservation-system/internal/logging"
+)
+
+// MinSecretLength is the shortest JWT signing secret accepted, in bytes.
+const MinSecretLength = 32
+
+// ErrInvalidToken is returned for a token that is neither a known API key nor a
+// valid JWT.
+var ErrInvalidToken = errors.New("invalid token")
+
+// APIKey is a static bearer token and the principal it authenticates.
+type APIKey struct {
+	Key       string
+	Principal Principal
+}
+
+// Authenticator checks bearer tokens against static API keys and JWTs signed
+// with HS256 and a shared secret.
+type Authenticator struct {
+	apiKeys []APIKey
+	secret  []byte
+
+	// disabled lets every request through as an admin's.
+	disabled bool
+}
+
+// Claims are the claims of an API JWT. The subject is the provider or client
+// ID, and the role one of the Role constants.
+type Claims struct {
+	Role Role `json:"role"`
+	jwt.RegisteredClaims
+}
+
+// NewAuthenticator returns an Authenticator for the given API keys and JWT
+// secret. Without a secret, JWTs are rejected.
+func NewAuthenticator(apiKeys []APIKey, secret string) (*Authenticator, error) {
+	for _, apiKey := range apiKeys {
+		if apiKey.Key == "" {
+			return nil, errors.New("API key must not be empty")
+		}
+		if err := apiKey.Principal.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid API key: %w", err)
+		}
+	}
+	if secret != "" && len(secret) < MinSecretLength {
+		return nil, fmt.Errorf("JWT secret must be at least %d bytes", MinSecretLength)
+	}
+	return &Authenticator{apiKeys: apiKeys, secret: []byte(secret)}, nil
+}
+
+// NewDisabledAuthenticator returns an Authenticator that checks no tokens and
+// treats every request as an admin's. It is meant for local development only.
+func NewDisabledAuthenticator() *Authenticator {
+	return &Authenticator{disabled: true}
+}
+
+// Enabled reports whether any API key or JWT secret is configured.
+func (a *Authenticator) Enabled() bool {
+	return len(a.apiKeys) > 0 || len(a.secret) > 0
+}
+
+// Disabled reports whether authentication was explicitly turned off.
+func (a *Authenticator) Disabled() bool {
+	return a.disabled
+}
+
+// Authenticate returns the principal of a bearer token.
+func (a *Authenticator) Authenticate(token string) (Principal, error) {
+	for _, apiKey := range a.apiKeys {
+		if subtle.ConstantTimeCompare([]byte(apiKey.Key), []byte(token)) == 1 {
+			return apiKey.Principal, nil
+		}
+	}
+	if len(a.secret) == 0 {
+		return Principal{}, ErrInvalidToken
+	}
+
+	var claims Claims
+	_, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (interface{}, error) {
+		return a.secret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil {
+		return Principal{}, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	principal := Principal{Role: claims.Role, Subject: claims.Subject}
+	if err := principal.Validate(); err != nil {
+		return Principal{}, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	return principal, nil
+}
+
+// IssueToken signs a JWT for a principal that expires after ttl.
+func (a *Authenticator) IssueToken(principal Principal, ttl time.Duration) (string, error) {
+	if len(a.secret) == 0 {
+		return "", errors.New("no JWT secret is configured")
+	}
+	if err := principal.Validate(); err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	claims := Claims{
+		Role: principal.Role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   principal.Subject,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(a.secret)
+}
+
+// Middleware authenticates the bearer token in the Authorization header and
+// puts its principal into the request context. Requests without a valid token
+// get a Twirp unauthenticated error, so an authenticator without API keys or
+// a JWT secret rejects everything. Only a disabled authenticator treats every
+// request as an admin's.
+func (a *Authenticator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal := Principal{Role: RoleAdmin}
+		if !a.disabled {
+			token, ok := bearerToken(r)
+			if !ok {
+				twirp.WriteError(w, twirp.NewError(twirp.Unauthenticated, "missing bearer token"))
+				return
+			}
+			var err error
+			principal, err = a.Authenticate(token)
+			if err != nil {
+				slog.InfoContext(r.Context(), "Rejected bearer token", "error", err)
+				twirp.WriteError(w, twirp.NewError(twirp.Unauthenticated, "invalid bearer token"))
+				return
+			}
+		}
+
+		ctx := NewContext(r.Context(), principal)
+		ctx = logging.WithAttrs(ctx, slog.String("role", string(principal.Role)), slog.String("subject", principal.Subject))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// bearerToken returns the token of an "Authorization: Bearer <token>" header.
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+	return strings.TrimSpace(token), true
+}
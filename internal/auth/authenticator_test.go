@@ -0,0 +1,120 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+func TestNewAuthenticatorChecksConfiguration(t *testing.T) {
+	if _, err := NewAuthenticator(nil, "too short"); err == nil {
+		t.Fatalf("NewAuthenticator accepted a short secret")
+	}
+	if _, err := NewAuthenticator([]APIKey{{Key: "", Principal: Principal{Role: RoleAdmin}}}, ""); err == nil {
+		t.Fatalf("NewAuthenticator accepted an empty API key")
+	}
+	if _, err := NewAuthenticator([]APIKey{{Key: "k", Principal: Principal{Role: RoleClient}}}, ""); err == nil {
+		t.Fatalf("NewAuthenticator accepted a client key without a subject")
+	}
+	if _, err := NewAuthenticator([]APIKey{{Key: "k", Principal: Principal{Role: "owner", Subject: "x"}}}, ""); err == nil {
+		t.Fatalf("NewAuthenticator accepted an unknown role")
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	a, err := NewAuthenticator([]APIKey{{Key: "provider-key", Principal: Provider("p1")}}, testSecret)
+	if err != nil {
+		t.Fatalf("NewAuthenticator: %v", err)
+	}
+
+	principal, err := a.Authenticate("provider-key")
+	if err != nil || principal != Provider("p1") {
+		t.Fatalf("Authenticate of an API key: got %v, %v, want provider p1", principal, err)
+	}
+
+	token, err := a.IssueToken(Client("c1"), time.Hour)
+	if err != nil {
+		t.Fatalf("IssueToken: %v", err)
+	}
+	principal, err = a.Authenticate(token)
+	if err != nil || principal != Client("c1") {
+		t.Fatalf("Authenticate of a JWT: got %v, %v, want client c1", principal, err)
+	}
+
+	expired, err := a.IssueToken(Client("c1"), -time.Minute)
+	if err != nil {
+		t.Fatalf("IssueToken: %v", err)
+	}
+	other, err := NewAuthenticator(nil, strings.Repeat("x", MinSecretLength))
+	if err != nil {
+		t.Fatalf("NewAuthenticator: %v", err)
+	}
+	foreign, err := other.IssueToken(Principal{Role: RoleAdmin}, time.Hour)
+	if err != nil {
+		t.Fatalf("IssueToken: %v", err)
+	}
+	for name, token := range map[string]string{
+		"an unknown key":          "other-key",
+		"an expired JWT":          expired,
+		"a JWT of another secret": foreign,
+	} {
+		if _, err := a.Authenticate(token); !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("Authenticate with %s: got %v, want ErrInvalidToken", name, err)
+		}
+	}
+
+	// Without a secret only API keys are accepted
+	keysOnly, err := NewAuthenticator([]APIKey{{Key: "provider-key", Principal: Provider("p1")}}, "")
+	if err != nil {
+		t.Fatalf("NewAuthenticator: %v", err)
+	}
+	if _, err := keysOnly.Authenticate(token); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("Authenticate of a JWT without a secret: got %v, want ErrInvalidToken", err)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	a, err := NewAuthenticator([]APIKey{{Key: "client-key", Principal: Client("c1")}}, "")
+	if err != nil {
+		t.Fatalf("NewAuthenticator: %v", err)
+	}
+
+	// serve returns the status of a request and the principal it reached the
+	// handler with
+	serve := func(a *Authenticator, header string) (int, Principal) {
+		var got Principal
+		handler := a.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got, _ = FromContext(r.Context())
+		}))
+		req := httptest.NewRequest(http.MethodPost, "/twirp/reservation.ReservationService/GetProvider", nil)
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code, got
+	}
+
+	if code, principal := serve(a, "Bearer client-key"); code != http.StatusOK || principal != Client("c1") {
+		t.Fatalf("valid key: got %d as %v, want 200 as client c1", code, principal)
+	}
+	for _, header := range []string{"", "client-key", "Basic client-key", "Bearer other-key"} {
+		if code, _ := serve(a, header); code != http.StatusUnauthorized {
+			t.Errorf("Authorization %q: got %d, want 401", header, code)
+		}
+	}
+
+	// An authenticator without keys or a secret rejects everything, and only a
+	// disabled one lets requests through, as an admin's
+	if code, _ := serve(&Authenticator{}, "Bearer client-key"); code != http.StatusUnauthorized {
+		t.Fatalf("unconfigured authenticator: got %d, want 401", code)
+	}
+	if code, principal := serve(NewDisabledAuthenticator(), ""); code != http.StatusOK || principal.Role != RoleAdmin {
+		t.Fatalf("disabled authenticator: got %d as %v, want 200 as admin", code, principal)
+	}
+}
@@ -0,0 +1,493 @@
+// Package config loads the server configuration from defaults, an optional
+// YAML or TOML file, environment variables and command-line flags, in that
+// order of precedence from lowest to highest.
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+
+	"github.com/manueldelreal/health-reservation-system/internal/auth"
+)
+
+// Config holds the settings each deployment can tune.
+type Config struct {
+	// DatabaseDSN is a SQLite file or a PostgreSQL DSN.
+	DatabaseDSN string
+
+	// Port is the HTTP port the server listens on.
+	Port int
+
+	// CleanupInterval is how often expired holds are released.
+	CleanupInterval time.Duration
+
+	// HoldExpiry is how long a reserved slot is held before it must be
+	// confirmed.
+	HoldExpiry time.Duration
+
+	// MaxHoldExtensions is how many times a hold can be extended by another
+	// HoldExpiry.
+	MaxHoldExtensions int
+
+	// WaitlistOfferExpiry is how long a freed slot offered to a waitlisted
+	// client is held for them to accept.
+	WaitlistOfferExpiry time.Duration
+
+	// MinLeadTime is how far ahead slots must be booked, for providers that do
+	// not set their own.
+	MinLeadTime time.Duration
+
+	// CancellationCutoff is the minimum time left before a reservation starts
+	// for it to still be cancellable.
+	CancellationCutoff time.Duration
+
+	// MaterializationHorizon is how far ahead availability rules are expanded
+	// into slots.
+	MaterializationHorizon time.Duration
+
+	// MaterializationInterval is how often availability rules are expanded.
+	MaterializationInterval time.Duration
+
+	// IdempotencyTTL is how long responses to requests made with an
+	// idempotency key are replayed for retries.
+	IdempotencyTTL time.Duration
+
+	// ShutdownTimeout is how long shutdown waits for in-flight requests and
+	// background jobs to finish.
+	ShutdownTimeout time.Duration
+
+	// LogLevel is the lowest level logged: debug, info, warn or error.
+	LogLevel string
+
+	// TracingExporter is where traces go: none, stdout, file or otlp.
+	TracingExporter string
+
+	// TracingFile is the file the file exporter appends traces to.
+	TracingFile string
+
+	// TracingEndpoint is the OTLP/HTTP collector URL of the otlp exporter.
+	// When empty, the standard OTEL_EXPORTER_OTLP_* variables apply.
+	TracingEndpoint string
+
+	// APIKeys are the static bearer tokens accepted and the principal each
+	// authenticates.
+	APIKeys []auth.APIKey
+
+	// JWTSecret is the HS256 secret JWT bearer tokens are signed with.
+	JWTSecret string
+
+	// AuthDisabled turns authentication off, treating every request as an
+	// admin's. Without it, at least one API key or a JWT secret is required.
+	AuthDisabled bool
+}
+
+// Default returns the configuration used when nothing is overridden.
+func Default() Config {
+	return Config{
+		DatabaseDSN:             "file:health_reservation.db?cache=shared&mode=rwc",
+		Port:                    8080,
+		CleanupInterval:         1 * time.Minute,
+		HoldExpiry:              30 * time.Minute,
+		MaxHoldExtensions:       2,
+		WaitlistOfferExpiry:     1 * time.Hour,
+		MinLeadTime:             24 * time.Hour,
+		CancellationCutoff:      24 * time.Hour,
+		MaterializationHorizon:  28 * 24 * time.Hour,
+		MaterializationInterval: 1 * time.Hour,
+		IdempotencyTTL:          24 * time.Hour,
+		ShutdownTimeout:         30 * time.Second,
+		LogLevel:                "info",
+		TracingExporter:         "none",
+		TracingFile:             "traces.json",
+	}
+}
+
+// Addr returns the address the server listens on.
+func (c Config) Addr() string {
+	return fmt.Sprintf(":%d", c.Port)
+}
+
+// Validate checks that every setting is usable.
+func (c Config) Validate() error {
+	var problems []string
+	if c.DatabaseDSN == "" {
+		problems = append(problems, "database DSN is required")
+	}
+	if c.Port < 1 || c.Port > 65535 {
+		problems = append(problems, fmt.Sprintf("port must be between 1 and 65535, got %d", c.Port))
+	}
+	if c.CleanupInterval <= 0 {
+		problems = append(problems, "cleanup interval must be positive")
+	}
+	if c.HoldExpiry <= 0 {
+		problems = append(problems, "hold expiry must be positive")
+	}
+	if c.MaxHoldExtensions < 0 {
+		problems = append(problems, "maximum hold extensions must not be negative")
+	}
+	if c.WaitlistOfferExpiry <= 0 {
+		problems = append(problems, "waitlist offer expiry must be positive")
+	}
+	if c.MinLeadTime < 0 {
+		problems = append(problems, "minimum lead time must not be negative")
+	}
+	if c.CancellationCutoff < 0 {
+		problems = append(problems, "cancellation cutoff must not be negative")
+	}
+	if c.MaterializationHorizon <= 0 {
+		problems = append(problems, "materialization horizon must be positive")
+	}
+	if c.MaterializationInterval <= 0 {
+		problems = append(problems, "materialization interval must be positive")
+	}
+	if c.IdempotencyTTL <= 0 {
+		problems = append(problems, "idempotency TTL must be positive")
+	}
+	if c.ShutdownTimeout <= 0 {
+		problems = append(problems, "shutdown timeout must be positive")
+	}
+	switch c.LogLevel {
+	case "debug", "info", "warn", "error":
+	default:
+		problems = append(problems, fmt.Sprintf("log level must be debug, info, warn or error, got %q", c.LogLevel))
+	}
+	switch c.TracingExporter {
+	case "none", "stdout", "otlp":
+	case "file":
+		if c.TracingFile == "" {
+			problems = append(problems, "tracing file is required by the file exporter")
+		}
+	default:
+		problems = append(problems, fmt.Sprintf("tracing exporter must be none, stdout, file or otlp, got %q", c.TracingExporter))
+	}
+	for i, apiKey := range c.APIKeys {
+		if apiKey.Key == "" {
+			problems = append(problems, fmt.Sprintf("API key %d is empty", i+1))
+		} else if err := apiKey.Principal.Validate(); err != nil {
+			problems = append(problems, fmt.Sprintf("API key %d: %v", i+1, err))
+		}
+	}
+	if c.JWTSecret != "" && len(c.JWTSecret) < auth.MinSecretLength {
+		problems = append(problems, fmt.Sprintf("JWT secret must be at least %d bytes", auth.MinSecretLength))
+	}
+	hasCredentials := len(c.APIKeys) > 0 || c.JWTSecret != ""
+	if c.AuthDisabled && hasCredentials {
+		problems = append(problems, "API keys or a JWT secret must not be set when authentication is disabled")
+	}
+	if !c.AuthDisabled && !hasCredentials {
+		problems = append(problems, "API keys or a JWT secret are required, unless authentication is explicitly disabled")
+	}
+
+	if len(problems) > 0 {
+		return errors.New("invalid configuration: " + strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// Load builds the server configuration from the defaults, the config file
+// named by -config or CONFIG_FILE, environment variables and the flags in
+// args, then validates it.
+func Load(args []string) (Config, error) {
+	fs := flag.NewFlagSet("health-reservation-server", flag.ContinueOnError)
+	configFile := fs.String("config", os.Getenv("CONFIG_FILE"), "YAML or TOML config file")
+	dsn := fs.String("dsn", "", "database DSN, SQLite file or PostgreSQL")
+	port := fs.Int("port", 0, "HTTP port to listen on")
+	cleanupInterval := fs.Duration("cleanup-interval", 0, "how often expired holds are released")
+	holdExpiry := fs.Duration("hold-expiry", 0, "how long a reserved slot is held before it must be confirmed")
+	maxHoldExtensions := fs.Int("max-hold-extensions", 0, "how many times a hold can be extended")
+	waitlistOfferExpiry := fs.Duration("waitlist-offer-expiry", 0, "how long a slot offered to the waitlist is held for acceptance")
+	minLeadTime := fs.Duration("min-lead-time", 0, "default booking lead time for providers without their own")
+	cancellationCutoff := fs.Duration("cancellation-cutoff", 0, "latest time before a reservation starts that it can be cancelled")
+	materializationHorizon := fs.Duration("materialization-horizon", 0, "how far ahead availability rules are expanded into slots")
+	materializationInterval := fs.Duration("materialization-interval", 0, "how often availability rules are expanded")
+	idempotencyTTL := fs.Duration("idempotency-ttl", 0, "how long responses to requests with an idempotency key are replayed")
+	shutdownTimeout := fs.Duration("shutdown-timeout", 0, "how long shutdown waits for requests and jobs to finish")
+	logLevel := fs.String("log-level", "", "lowest level logged: debug, info, warn or error")
+	tracingExporter := fs.String("tracing-exporter", "", "where traces go: none, stdout, file or otlp")
+	tracingFile := fs.String("tracing-file", "", "file the file trace exporter appends to")
+	tracingEndpoint := fs.String("tracing-endpoint", "", "OTLP/HTTP collector URL of the otlp trace exporter")
+	authDisabled := fs.Bool("auth-disabled", false, "turn authentication off and treat every request as an admin's")
+	if err := fs.Parse(args); err != nil {
+		return Config{}, err
+	}
+
+	cfg := Default()
+	if *configFile != "" {
+		if err := cfg.LoadFile(*configFile); err != nil {
+			return Config{}, err
+		}
+	}
+	if err := cfg.LoadEnv(); err != nil {
+		return Config{}, err
+	}
+
+	// Flags that were given explicitly override everything else
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "dsn":
+			cfg.DatabaseDSN = *dsn
+		case "port":
+			cfg.Port = *port
+		case "cleanup-interval":
+			cfg.CleanupInterval = *cleanupInterval
+		case "hold-expiry":
+			cfg.HoldExpiry = *holdExpiry
+		case "max-hold-extensions":
+			cfg.MaxHoldExtensions = *maxHoldExtensions
+		case "waitlist-offer-expiry":
+			cfg.WaitlistOfferExpiry = *waitlistOfferExpiry
+		case "min-lead-time":
+			cfg.MinLeadTime = *minLeadTime
+		case "cancellation-cutoff":
+			cfg.CancellationCutoff = *cancellationCutoff
+		case "materialization-horizon":
+			cfg.MaterializationHorizon = *materializationHorizon
+		case "materialization-interval":
+			cfg.MaterializationInterval = *materializationInterval
+		case "idempotency-ttl":
+			cfg.IdempotencyTTL = *idempotencyTTL
+		case "shutdown-timeout":
+			cfg.ShutdownTimeout = *shutdownTimeout
+		case "log-level":
+			cfg.LogLevel = *logLevel
+		case "tracing-exporter":
+			cfg.TracingExporter = *tracingExporter
+		case "tracing-file":
+			cfg.TracingFile = *tracingFile
+		case "tracing-endpoint":
+			cfg.TracingEndpoint = *tracingEndpoint
+		case "auth-disabled":
+			cfg.AuthDisabled = *authDisabled
+		}
+	})
+
+	if err := cfg.Validate(); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+// fileConfig is the layout of a config file. Durations are written the way
+// time.ParseDuration reads them, e.g. "30m" or "672h".
+type fileConfig struct {
+	DatabaseDSN             *string   `yaml:"database_dsn" toml:"database_dsn"`
+	Port                    *int      `yaml:"port" toml:"port"`
+	CleanupInterval         *duration `yaml:"cleanup_interval" toml:"cleanup_interval"`
+	HoldExpiry              *duration `yaml:"hold_expiry" toml:"hold_expiry"`
+	MaxHoldExtensions       *int      `yaml:"max_hold_extensions" toml:"max_hold_extensions"`
+	WaitlistOfferExpiry     *duration `yaml:"waitlist_offer_expiry" toml:"waitlist_offer_expiry"`
+	MinLeadTime             *duration `yaml:"min_lead_time" toml:"min_lead_time"`
+	CancellationCutoff      *duration `yaml:"cancellation_cutoff" toml:"cancellation_cutoff"`
+	MaterializationHorizon  *duration `yaml:"materialization_horizon" toml:"materialization_horizon"`
+	MaterializationInterval *duration `yaml:"materialization_interval" toml:"materialization_interval"`
+	IdempotencyTTL          *duration `yaml:"idempotency_ttl" toml:"idempotency_ttl"`
+	ShutdownTimeout         *duration `yaml:"shutdown_timeout" toml:"shutdown_timeout"`
+	LogLevel                *string   `yaml:"log_level" toml:"log_level"`
+	TracingExporter         *string   `yaml:"tracing_exporter" toml:"tracing_exporter"`
+	TracingFile             *string   `yaml:"tracing_file" toml:"tracing_file"`
+	TracingEndpoint         *string   `yaml:"tracing_endpoint" toml:"tracing_endpoint"`
+	APIKeys                 []apiKey  `yaml:"api_keys" toml:"api_keys"`
+	JWTSecret               *string   `yaml:"jwt_secret" toml:"jwt_secret"`
+	AuthDisabled            *bool     `yaml:"auth_disabled" toml:"auth_disabled"`
+}
+
+// apiKey is an API key entry of a config file.
+type apiKey struct {
+	Key     string `yaml:"key" toml:"key"`
+	Role    string `yaml:"role" toml:"role"`
+	Subject string `yaml:"subject" toml:"subject"`
+}
+
+// duration is a time.Duration read from text such as "30m".
+type duration time.Duration
+
+func (d *duration) UnmarshalText(text []byte) error {
+	parsed, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*d = duration(parsed)
+	return nil
+}
+
+// LoadFile overrides the settings present in a YAML (.yaml, .yml) or TOML
+// (.toml) file. Unknown keys are rejected.
+func (c *Config) LoadFile(path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var file fileConfig
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(strings.NewReader(string(content)))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&file); err != nil {
+			return fmt.Errorf("invalid config file %s: %w", path, err)
+		}
+	case ".toml":
+		meta, err := toml.Decode(string(content), &file)
+		if err != nil {
+			return fmt.Errorf("invalid config file %s: %w", path, err)
+		}
+		if undecoded := meta.Undecoded(); len(undecoded) > 0 {
+			return fmt.Errorf("invalid config file %s: unknown key %s", path, undecoded[0])
+		}
+	default:
+		return fmt.Errorf("config file %s must be .yaml, .yml or .toml", path)
+	}
+
+	setString(&c.DatabaseDSN, file.DatabaseDSN)
+	if file.Port != nil {
+		c.Port = *file.Port
+	}
+	setDuration(&c.CleanupInterval, file.CleanupInterval)
+	setDuration(&c.HoldExpiry, file.HoldExpiry)
+	if file.MaxHoldExtensions != nil {
+		c.MaxHoldExtensions = *file.MaxHoldExtensions
+	}
+	setDuration(&c.WaitlistOfferExpiry, file.WaitlistOfferExpiry)
+	setDuration(&c.MinLeadTime, file.MinLeadTime)
+	setDuration(&c.CancellationCutoff, file.CancellationCutoff)
+	setDuration(&c.MaterializationHorizon, file.MaterializationHorizon)
+	setDuration(&c.MaterializationInterval, file.MaterializationInterval)
+	setDuration(&c.IdempotencyTTL, file.IdempotencyTTL)
+	setDuration(&c.ShutdownTimeout, file.ShutdownTimeout)
+	setString(&c.LogLevel, file.LogLevel)
+	setString(&c.TracingExporter, file.TracingExporter)
+	setString(&c.TracingFile, file.TracingFile)
+	setString(&c.TracingEndpoint, file.TracingEndpoint)
+	if file.APIKeys != nil {
+		c.APIKeys = nil
+		for _, key := range file.APIKeys {
+			c.APIKeys = append(c.APIKeys, auth.APIKey{
+				Key:       key.Key,
+				Principal: auth.Principal{Role: auth.Role(key.Role), Subject: key.Subject},
+			})
+		}
+	}
+	setString(&c.JWTSecret, file.JWTSecret)
+	if file.AuthDisabled != nil {
+		c.AuthDisabled = *file.AuthDisabled
+	}
+	return nil
+}
+
+// setString overrides dst if the file set a value.
+func setString(dst *string, value *string) {
+	if value != nil {
+		*dst = *value
+	}
+}
+
+// setDuration overrides dst if the file set a value.
+func setDuration(dst *time.Duration, value *duration) {
+	if value != nil {
+		*dst = time.Duration(*value)
+	}
+}
+
+// LoadEnv overrides the settings given as environment variables.
+func (c *Config) LoadEnv() error {
+	if value := os.Getenv("DATABASE_DSN"); value != "" {
+		c.DatabaseDSN = value
+	}
+	if value := os.Getenv("LOG_LEVEL"); value != "" {
+		c.LogLevel = value
+	}
+	if value := os.Getenv("TRACING_EXPORTER"); value != "" {
+		c.TracingExporter = value
+	}
+	if value := os.Getenv("TRACING_FILE"); value != "" {
+		c.TracingFile = value
+	}
+	if value := os.Getenv("TRACING_ENDPOINT"); value != "" {
+		c.TracingEndpoint = value
+	}
+	if value := os.Getenv("JWT_SECRET"); value != "" {
+		c.JWTSecret = value
+	}
+	if value := os.Getenv("API_KEYS"); value != "" {
+		apiKeys, err := parseAPIKeys(value)
+		if err != nil {
+			return fmt.Errorf("invalid API_KEYS: %w", err)
+		}
+		c.APIKeys = apiKeys
+	}
+	if value := os.Getenv("AUTH_DISABLED"); value != "" {
+		authDisabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid AUTH_DISABLED: %s", value)
+		}
+		c.AuthDisabled = authDisabled
+	}
+	if value := os.Getenv("PORT"); value != "" {
+		port, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid PORT: %s", value)
+		}
+		c.Port = port
+	}
+	if value := os.Getenv("MAX_HOLD_EXTENSIONS"); value != "" {
+		maxHoldExtensions, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid MAX_HOLD_EXTENSIONS: %s", value)
+		}
+		c.MaxHoldExtensions = maxHoldExtensions
+	}
+
+	durations := []struct {
+		name string
+		dst  *time.Duration
+	}{
+		{"CLEANUP_INTERVAL", &c.CleanupInterval},
+		{"HOLD_EXPIRY", &c.HoldExpiry},
+		{"WAITLIST_OFFER_EXPIRY", &c.WaitlistOfferExpiry},
+		{"MIN_LEAD_TIME", &c.MinLeadTime},
+		{"CANCELLATION_CUTOFF", &c.CancellationCutoff},
+		{"MATERIALIZATION_HORIZON", &c.MaterializationHorizon},
+		{"MATERIALIZATION_INTERVAL", &c.MaterializationInterval},
+		{"IDEMPOTENCY_TTL", &c.IdempotencyTTL},
+		{"SHUTDOWN_TIMEOUT", &c.ShutdownTimeout},
+	}
+	for _, d := range durations {
+		value := os.Getenv(d.name)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %s", d.name, value)
+		}
+		*d.dst = parsed
+	}
+	return nil
+}
+
+// parseAPIKeys reads a comma-separated list of key:role[:subject] entries, such
+// as "k1:admin,k2:provider:p1".
+func parseAPIKeys(value string) ([]auth.APIKey, error) {
+	var apiKeys []auth.APIKey
+	for _, entry := range strings.Split(value, ",") {
+		parts := strings.Split(strings.TrimSpace(entry), ":")
+		if len(parts) < 2 || len(parts) > 3 {
+			return nil, errors.New("entries must be key:role[:subject]")
+		}
+		principal := auth.Principal{Role: auth.Role(parts[1])}
+		if len(parts) == 3 {
+			principal.Subject = parts[2]
+		}
+		apiKeys = append(apiKeys, auth.APIKey{Key: parts[0], Principal: principal})
+	}
+	return apiKeys, nil
+}
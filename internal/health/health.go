@@ -0,0 +1,108 @@
+// Package health serves the liveness and readiness endpoints probed by the
+// orchestrator.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Check is a single readiness check. It passes when Run returns nil.
+type Check struct {
+	Name string
+	Run  func(ctx context.Context) error
+}
+
+// CheckResult is the outcome of one check in a readiness response.
+type CheckResult struct {
+	Name      string  `json:"name"`
+	Status    string  `json:"status"`
+	LatencyMs float64 `json:"latency_ms"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// Response is the JSON body of the health endpoints.
+type Response struct {
+	Status string        `json:"status"`
+	Checks []CheckResult `json:"checks,omitempty"`
+}
+
+// Status values of a response or check.
+const (
+	StatusOK   = "ok"
+	StatusFail = "fail"
+)
+
+// LivenessHandler reports that the process is up and serving requests.
+func LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, Response{Status: StatusOK})
+	})
+}
+
+// ReadinessHandler runs every check concurrently, each limited by timeout, and
+// responds 200 if they all pass or 503 otherwise.
+func ReadinessHandler(timeout time.Duration, checks ...Check) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+
+		results := make([]CheckResult, len(checks))
+		var wg sync.WaitGroup
+		for i, check := range checks {
+			wg.Add(1)
+			go func(i int, check Check) {
+				defer wg.Done()
+				results[i] = run(ctx, check)
+			}(i, check)
+		}
+		wg.Wait()
+
+		response := Response{Status: StatusOK, Checks: results}
+		code := http.StatusOK
+		for _, result := range results {
+			if result.Status != StatusOK {
+				response.Status = StatusFail
+				code = http.StatusServiceUnavailable
+			}
+		}
+		writeJSON(w, code, response)
+	})
+}
+
+// run runs a check and times it. A check that outlives ctx fails even if it
+// does not watch ctx itself.
+func run(ctx context.Context, check Check) CheckResult {
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() { done <- check.Run(ctx) }()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	result := CheckResult{
+		Name:      check.Name,
+		Status:    StatusOK,
+		LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+	}
+	if err != nil {
+		result.Status = StatusFail
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// writeJSON writes a response with the given status code.
+func writeJSON(w http.ResponseWriter, code int, response Response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(response)
+}
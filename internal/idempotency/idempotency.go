@@ -0,0 +1,38 @@
+// Package idempotency carries the idempotency key of a request, given in the
+// Idempotency-Key header, in the request context.
+package idempotency
+
+import (
+	"context"
+	"net/http"
+)
+
+// Header is the HTTP header a client sends an idempotency key in.
+const Header = "Idempotency-Key"
+
+// ReplayedHeader is the HTTP response header set on a response replayed for a
+// retry.
+const ReplayedHeader = "Idempotent-Replayed"
+
+type keyContextKey struct{}
+
+// NewContext returns a context carrying an idempotency key.
+func NewContext(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, keyContextKey{}, key)
+}
+
+// FromContext returns the idempotency key of a request, or "" if it has none.
+func FromContext(ctx context.Context) string {
+	key, _ := ctx.Value(keyContextKey{}).(string)
+	return key
+}
+
+// Middleware puts the Idempotency-Key header of a request into its context.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if key := r.Header.Get(Header); key != "" {
+			r = r.WithContext(NewContext(r.Context(), key))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
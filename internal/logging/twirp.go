@@ -0,0 +1,137 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/twitchtv/twirp"
+)
+
+// RequestIDHeader is the response header that carries the request ID.
+const RequestIDHeader = "X-Request-Id"
+
+// requestLog collects what is known about a request until it is logged once
+// the response has been sent.
+type requestLog struct {
+	start time.Time
+
+	mu    sync.Mutex
+	attrs []slog.Attr
+	err   twirp.Error
+}
+
+type requestLogKey struct{}
+
+// The getters of request and response messages that name the records a call
+// is about.
+type (
+	providerIDGetter    interface{ GetProviderId() string }
+	clientIDGetter      interface{ GetClientId() string }
+	reservationIDGetter interface{ GetReservationId() string }
+	slotIDGetter        interface{ GetSlotId() string }
+)
+
+// ServerHooks returns Twirp hooks that give every request an ID, returned in
+// the X-Request-Id header and added to every record logged with its context,
+// and log the method, duration and outcome of the request once it is sent.
+// Use them together with Interceptor to also log the IDs in the request.
+func ServerHooks() *twirp.ServerHooks {
+	return &twirp.ServerHooks{
+		RequestReceived: func(ctx context.Context) (context.Context, error) {
+			requestID := ulid.Make().String()
+			twirp.SetHTTPResponseHeader(ctx, RequestIDHeader, requestID)
+			ctx = WithAttrs(ctx, slog.String("request_id", requestID))
+			return context.WithValue(ctx, requestLogKey{}, &requestLog{start: time.Now()}), nil
+		},
+		Error: func(ctx context.Context, err twirp.Error) context.Context {
+			if request, ok := ctx.Value(requestLogKey{}).(*requestLog); ok {
+				request.mu.Lock()
+				request.err = err
+				request.mu.Unlock()
+			}
+			return ctx
+		},
+		ResponseSent: func(ctx context.Context) {
+			request, ok := ctx.Value(requestLogKey{}).(*requestLog)
+			if !ok {
+				return
+			}
+			method, _ := twirp.MethodName(ctx)
+			status, _ := twirp.StatusCode(ctx)
+
+			request.mu.Lock()
+			defer request.mu.Unlock()
+
+			attrs := []slog.Attr{
+				slog.String("method", method),
+				slog.String("status", status),
+				slog.Float64("duration_ms", float64(time.Since(request.start).Microseconds())/1000),
+			}
+			attrs = append(attrs, request.attrs...)
+
+			level := slog.LevelInfo
+			if request.err == nil {
+				attrs = append(attrs, slog.String("outcome", "ok"))
+			} else {
+				attrs = append(attrs,
+					slog.String("outcome", "error"),
+					slog.String("error_code", string(request.err.Code())),
+					slog.String("error", request.err.Msg()),
+				)
+				if code, _ := strconv.Atoi(status); code >= 500 {
+					level = slog.LevelError
+				}
+			}
+			slog.LogAttrs(ctx, level, "Request handled", attrs...)
+		},
+	}
+}
+
+// Interceptor returns a Twirp interceptor that adds the provider, client,
+// reservation and slot IDs of each request, and the ID of a reservation the
+// response returns, to the line ServerHooks logs for it.
+func Interceptor() twirp.Interceptor {
+	return func(next twirp.Method) twirp.Method {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			resp, err := next(ctx, req)
+
+			request, ok := ctx.Value(requestLogKey{}).(*requestLog)
+			if !ok {
+				return resp, err
+			}
+			attrs := idAttrs(req)
+			if _, ok := req.(reservationIDGetter); !ok && err == nil {
+				if getter, ok := resp.(reservationIDGetter); ok && getter.GetReservationId() != "" {
+					attrs = append(attrs, slog.String("reservation_id", getter.GetReservationId()))
+				}
+			}
+
+			request.mu.Lock()
+			request.attrs = append(request.attrs, attrs...)
+			request.mu.Unlock()
+			return resp, err
+		}
+	}
+}
+
+// idAttrs returns the non-empty record IDs of a request message.
+func idAttrs(req interface{}) []slog.Attr {
+	var attrs []slog.Attr
+	if getter, ok := req.(providerIDGetter); ok && getter.GetProviderId() != "" {
+		attrs = append(attrs, slog.String("provider_id", getter.GetProviderId()))
+	}
+	if getter, ok := req.(clientIDGetter); ok && getter.GetClientId() != "" {
+		attrs = append(attrs, slog.String("client_id", getter.GetClientId()))
+	}
+	if getter, ok := req.(reservationIDGetter); ok && getter.GetReservationId() != "" {
+		attrs = append(attrs, slog.String("reservation_id", getter.GetReservationId()))
+	}
+	if getter, ok := req.(slotIDGetter); ok && getter.GetSlotId() != "" {
+		attrs = append(attrs, slog.String("slot_id", getter.GetSlotId()))
+	}
+	return attrs
+}
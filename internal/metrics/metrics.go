@@ -0,0 +1,241 @@
+// Package metrics exposes Prometheus metrics for the Twirp API and for booking
+// activity.
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/twitchtv/twirp"
+)
+
+const namespace = "reservation"
+
+// countTimeout bounds the query behind the available slots gauge on a scrape.
+const countTimeout = 5 * time.Second
+
+// Slot sources for SlotsCreated.
+const (
+	SourceSetAvailability  = "set_availability"
+	SourceAvailabilityRule = "availability_rule"
+)
+
+// SlotCounter counts the future Available slots of each provider.
+type SlotCounter func(ctx context.Context) (map[string]int, error)
+
+// Metrics holds the collectors of the service. A nil *Metrics records nothing,
+// so services can run without metrics.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	requests        *prometheus.CounterVec
+	errors          *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+
+	slotsCreated            *prometheus.CounterVec
+	reservationsCreated     prometheus.Counter
+	reservationsConfirmed   prometheus.Counter
+	reservationsCancelled   prometheus.Counter
+	reservationsRescheduled prometheus.Counter
+	reservationsExpired     prometheus.Counter
+	waitlistOffers          prometheus.Counter
+}
+
+// New creates the metrics in a registry of their own, together with the Go
+// runtime and process collectors and a gauge of Available slots per provider
+// read from countSlots on every scrape.
+func New(countSlots SlotCounter) *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "rpc_requests_total",
+			Help:      "Twirp requests handled, by method and HTTP status code.",
+		}, []string{"method", "status"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "rpc_errors_total",
+			Help:      "Twirp errors returned, by method and Twirp error code.",
+		}, []string{"method", "code"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "rpc_request_duration_seconds",
+			Help:      "Time taken to handle Twirp requests, by method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"}),
+		slotsCreated: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "slots_created_total",
+			Help:      "Slots created, by source.",
+		}, []string{"source"}),
+		reservationsCreated: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "reservations_created_total",
+			Help:      "Reservations created by ReserveSlot.",
+		}),
+		reservationsConfirmed: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "reservations_confirmed_total",
+			Help:      "Reservations confirmed.",
+		}),
+		reservationsCancelled: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "reservations_cancelled_total",
+			Help:      "Reservations cancelled.",
+		}),
+		reservationsRescheduled: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "reservations_rescheduled_total",
+			Help:      "Reservations moved to new slots.",
+		}),
+		reservationsExpired: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "reservations_expired_total",
+			Help:      "Unconfirmed reservations released by the expiry cleanup.",
+		}),
+		waitlistOffers: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "waitlist_offers_total",
+			Help:      "Freed slots offered to waitlisted clients.",
+		}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.requests,
+		m.errors,
+		m.requestDuration,
+		m.slotsCreated,
+		m.reservationsCreated,
+		m.reservationsConfirmed,
+		m.reservationsCancelled,
+		m.reservationsRescheduled,
+		m.reservationsExpired,
+		m.waitlistOffers,
+		&availableSlotsCollector{countSlots: countSlots},
+	)
+	return m
+}
+
+// Handler serves the metrics in the Prometheus text format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+type startTimeKey struct{}
+
+// ServerHooks returns Twirp hooks that count requests and errors and time
+// every request by method.
+func (m *Metrics) ServerHooks() *twirp.ServerHooks {
+	return &twirp.ServerHooks{
+		RequestReceived: func(ctx context.Context) (context.Context, error) {
+			return context.WithValue(ctx, startTimeKey{}, time.Now()), nil
+		},
+		Error: func(ctx context.Context, err twirp.Error) context.Context {
+			method, _ := twirp.MethodName(ctx)
+			m.errors.WithLabelValues(method, string(err.Code())).Inc()
+			return ctx
+		},
+		ResponseSent: func(ctx context.Context) {
+			method, _ := twirp.MethodName(ctx)
+			status, _ := twirp.StatusCode(ctx)
+			m.requests.WithLabelValues(method, status).Inc()
+			if start, ok := ctx.Value(startTimeKey{}).(time.Time); ok {
+				m.requestDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+			}
+		},
+	}
+}
+
+// SlotsCreated records n new slots from a source.
+func (m *Metrics) SlotsCreated(source string, n int) {
+	if m == nil {
+		return
+	}
+	m.slotsCreated.WithLabelValues(source).Add(float64(n))
+}
+
+// ReservationCreated records a new reservation.
+func (m *Metrics) ReservationCreated() {
+	if m == nil {
+		return
+	}
+	m.reservationsCreated.Inc()
+}
+
+// ReservationConfirmed records a confirmed reservation.
+func (m *Metrics) ReservationConfirmed() {
+	if m == nil {
+		return
+	}
+	m.reservationsConfirmed.Inc()
+}
+
+// ReservationCancelled records a cancelled reservation.
+func (m *Metrics) ReservationCancelled() {
+	if m == nil {
+		return
+	}
+	m.reservationsCancelled.Inc()
+}
+
+// ReservationRescheduled records a rescheduled reservation.
+func (m *Metrics) ReservationRescheduled() {
+	if m == nil {
+		return
+	}
+	m.reservationsRescheduled.Inc()
+}
+
+// ReservationsExpired records n reservations released by the expiry cleanup.
+func (m *Metrics) ReservationsExpired(n int) {
+	if m == nil {
+		return
+	}
+	m.reservationsExpired.Add(float64(n))
+}
+
+// WaitlistOffered records a freed slot offered to a waitlisted client.
+func (m *Metrics) WaitlistOffered() {
+	if m == nil {
+		return
+	}
+	m.waitlistOffers.Inc()
+}
+
+// availableSlotsCollector reports the current number of Available slots per
+// provider, read from storage on every scrape so it is right across replicas.
+type availableSlotsCollector struct {
+	countSlots SlotCounter
+}
+
+var availableSlotsDesc = prometheus.NewDesc(
+	prometheus.BuildFQName(namespace, "", "available_slots"),
+	"Future slots that can still be reserved, by provider.",
+	[]string{"provider_id"}, nil,
+)
+
+func (c *availableSlotsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- availableSlotsDesc
+}
+
+func (c *availableSlotsCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), countTimeout)
+	defer cancel()
+
+	counts, err := c.countSlots(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to count available slots", "error", err)
+		ch <- prometheus.NewInvalidMetric(availableSlotsDesc, err)
+		return
+	}
+	for providerID, count := range counts {
+		ch <- prometheus.MustNewConstMetric(availableSlotsDesc, prometheus.GaugeValue, float64(count), providerID)
+	}
+}
@@ -0,0 +1,327 @@
+// Package migrations applies the versioned SQL migrations that define the
+// database schema. Each migration is a pair of files under sql/<dialect>/,
+// NNNN_name.up.sql and NNNN_name.down.sql, and the versions applied to a
+// database are recorded in its schema_migrations table.
+package migrations
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+//go:embed sql
+var files embed.FS
+
+// Migration is a single schema change and the statements that undo it.
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// schemaMigration records a migration applied to the database.
+type schemaMigration struct {
+	Version   int `gorm:"primaryKey;autoIncrement:false"`
+	Name      string
+	AppliedAt time.Time
+}
+
+// Specify the table name for schemaMigration
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// Load returns the migrations for a GORM dialect ("sqlite" or "postgres"),
+// ordered by version.
+func Load(dialect string) ([]Migration, error) {
+	dir := path.Join("sql", dialect)
+	entries, err := fs.ReadDir(files, dir)
+	if err != nil {
+		return nil, fmt.Errorf("no migrations for database %q", dialect)
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		// Split NNNN_name.up.sql into its version, name and direction
+		base := entry.Name()
+		var direction string
+		switch {
+		case strings.HasSuffix(base, ".up.sql"):
+			direction = "up"
+		case strings.HasSuffix(base, ".down.sql"):
+			direction = "down"
+		default:
+			continue
+		}
+		stem := strings.TrimSuffix(base, "."+direction+".sql")
+		number, name, found := strings.Cut(stem, "_")
+		version, err := strconv.Atoi(number)
+		if !found || err != nil {
+			return nil, fmt.Errorf("invalid migration file name: %s", base)
+		}
+
+		content, err := fs.ReadFile(files, path.Join(dir, base))
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: name}
+			byVersion[version] = migration
+		}
+		if direction == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %04d_%s needs both an up and a down file", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Applied returns when each applied migration version was applied.
+func Applied(db *gorm.DB) (map[int]time.Time, error) {
+	if err := ensureTable(db); err != nil {
+		return nil, err
+	}
+
+	var rows []schemaMigration
+	if err := db.Order("version").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	applied := make(map[int]time.Time, len(rows))
+	for _, row := range rows {
+		applied[row.Version] = row.AppliedAt
+	}
+	return applied, nil
+}
+
+// Pending returns the migrations not yet applied to the database, in order.
+func Pending(db *gorm.DB) ([]Migration, error) {
+	migrations, err := Load(db.Dialector.Name())
+	if err != nil {
+		return nil, err
+	}
+	applied, err := Applied(db)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, migration := range migrations {
+		if _, ok := applied[migration.Version]; !ok {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
+
+// ErrUnversioned is returned by Up for a database that has tables but no
+// applied migrations, such as one set up by GORM's AutoMigrate before the
+// migrations existed. Its schema is older than the first migration, so it is
+// not upgraded in place.
+var ErrUnversioned = errors.New("database has tables but no applied migrations, migrate a new database and copy the data into it")
+
+// Up applies every pending migration, each in its own transaction, and returns
+// the ones it applied.
+func Up(db *gorm.DB) ([]Migration, error) {
+	applied, err := Applied(db)
+	if err != nil {
+		return nil, err
+	}
+	if len(applied) == 0 && db.Migrator().HasTable("providers") {
+		return nil, ErrUnversioned
+	}
+
+	pending, err := Pending(db)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, migration := range pending {
+		err := db.Transaction(func(tx *gorm.DB) error {
+			if err := execScript(tx, migration.Up); err != nil {
+				return err
+			}
+			return tx.Create(&schemaMigration{
+				Version:   migration.Version,
+				Name:      migration.Name,
+				AppliedAt: time.Now().UTC(),
+			}).Error
+		})
+		if err != nil {
+			return pending[:i], fmt.Errorf("migration %04d_%s: %w", migration.Version, migration.Name, err)
+		}
+	}
+	return pending, nil
+}
+
+// Down reverts the last steps applied migrations, newest first, and returns the
+// ones it reverted.
+func Down(db *gorm.DB, steps int) ([]Migration, error) {
+	migrations, err := Load(db.Dialector.Name())
+	if err != nil {
+		return nil, err
+	}
+	applied, err := Applied(db)
+	if err != nil {
+		return nil, err
+	}
+
+	var reverted []Migration
+	for i := len(migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+		migration := migrations[i]
+		if _, ok := applied[migration.Version]; !ok {
+			continue
+		}
+
+		err := db.Transaction(func(tx *gorm.DB) error {
+			if err := execScript(tx, migration.Down); err != nil {
+				return err
+			}
+			return tx.Delete(&schemaMigration{}, "version = ?", migration.Version).Error
+		})
+		if err != nil {
+			return reverted, fmt.Errorf("migration %04d_%s: %w", migration.Version, migration.Name, err)
+		}
+		reverted = append(reverted, migration)
+	}
+	return reverted, nil
+}
+
+// Check compares the migrated schema with the models, reporting missing tables,
+// model fields without a column, columns without a model field and columns
+// whose type cannot hold their field's values.
+func Check(db *gorm.DB, models ...interface{}) error {
+	var problems []string
+	for _, model := range models {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(model); err != nil {
+			return err
+		}
+		table := stmt.Schema.Table
+
+		if !db.Migrator().HasTable(model) {
+			problems = append(problems, fmt.Sprintf("table %s is missing", table))
+			continue
+		}
+
+		columnTypes, err := db.Migrator().ColumnTypes(model)
+		if err != nil {
+			return err
+		}
+		columns := make(map[string]string, len(columnTypes))
+		for _, columnType := range columnTypes {
+			columns[columnType.Name()] = columnType.DatabaseTypeName()
+		}
+
+		fields := make(map[string]bool, len(stmt.Schema.DBNames))
+		for _, name := range stmt.Schema.DBNames {
+			fields[name] = true
+			columnType, ok := columns[name]
+			if !ok {
+				problems = append(problems, fmt.Sprintf("column %s.%s is missing", table, name))
+				continue
+			}
+			field := stmt.Schema.LookUpField(name)
+			if !compatibleType(field.DataType, columnType) {
+				problems = append(problems, fmt.Sprintf("column %s.%s is %s, which does not match %s field %s", table, name, columnType, field.DataType, field.Name))
+			}
+		}
+		for _, columnType := range columnTypes {
+			if !fields[columnType.Name()] {
+				problems = append(problems, fmt.Sprintf("column %s.%s has no model field", table, columnType.Name()))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// columnTypes are the database types, as SQLite and PostgreSQL report them,
+// that can hold the values of each kind of model field.
+var columnTypes = map[schema.DataType][]string{
+	schema.Bool:   {"BOOLEAN", "BOOL"},
+	schema.Int:    {"INTEGER", "INT", "INT2", "INT4", "INT8", "SMALLINT", "BIGINT"},
+	schema.Uint:   {"INTEGER", "INT", "INT2", "INT4", "INT8", "SMALLINT", "BIGINT"},
+	schema.Float:  {"REAL", "FLOAT", "FLOAT4", "FLOAT8", "DOUBLE PRECISION", "NUMERIC"},
+	schema.String: {"TEXT", "VARCHAR", "CHAR", "BPCHAR", "CHARACTER VARYING"},
+	schema.Time:   {"DATETIME", "TIMESTAMP", "TIMESTAMPTZ", "DATE"},
+	schema.Bytes:  {"BLOB", "BYTEA"},
+}
+
+// compatibleType reports whether a column of the given database type can hold
+// the values of a field of the given kind. Lengths such as VARCHAR(255) are
+// ignored, and so are fields of kinds not listed in columnTypes.
+func compatibleType(dataType schema.DataType, databaseType string) bool {
+	allowed, ok := columnTypes[dataType]
+	if !ok {
+		return true
+	}
+	name, _, _ := strings.Cut(strings.ToUpper(strings.TrimSpace(databaseType)), "(")
+	for _, candidate := range allowed {
+		if strings.TrimSpace(name) == candidate {
+			return true
+		}
+	}
+	return false
+}
+
+// ensureTable creates the schema_migrations table if it does not exist yet.
+func ensureTable(db *gorm.DB) error {
+	return db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+    version INTEGER PRIMARY KEY,
+    name TEXT NOT NULL,
+    applied_at TIMESTAMP NOT NULL
+)`).Error
+}
+
+// execScript runs the statements of a migration file one at a time. Statements
+// are separated by semicolons, so migration files must not use semicolons
+// anywhere else.
+func execScript(tx *gorm.DB, script string) error {
+	for _, statement := range strings.Split(script, ";") {
+		if !hasSQL(statement) {
+			continue
+		}
+		if err := tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hasSQL reports whether a statement contains anything besides blank lines and
+// comments.
+func hasSQL(statement string) bool {
+	for _, line := range strings.Split(statement, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "--") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,3 @@
+-- Drop the clients table.
+
+DROP TABLE IF EXISTS clients;
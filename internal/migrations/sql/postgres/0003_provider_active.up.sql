@@ -0,0 +1,3 @@
+-- Providers can be deactivated. Existing providers stay active.
+
+ALTER TABLE providers ADD COLUMN active BOOLEAN NOT NULL DEFAULT TRUE; -- Inactive providers cannot be booked
@@ -0,0 +1,4 @@
+-- Drop the hold token and extension count.
+
+ALTER TABLE reservations DROP COLUMN hold_extensions;
+ALTER TABLE reservations DROP COLUMN hold_token_hash;
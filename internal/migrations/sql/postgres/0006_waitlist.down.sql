@@ -0,0 +1,3 @@
+-- Drop the waitlist.
+
+DROP TABLE IF EXISTS waitlist_entry;
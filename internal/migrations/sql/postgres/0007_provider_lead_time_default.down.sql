@@ -0,0 +1,6 @@
+-- Go back to 0 for the default lead time. Providers with a lead time of 0 get
+-- the default.
+
+UPDATE providers SET min_lead_time_minutes = 0 WHERE min_lead_time_minutes IS NULL;
+ALTER TABLE providers ALTER COLUMN min_lead_time_minutes SET DEFAULT 0;
+ALTER TABLE providers ALTER COLUMN min_lead_time_minutes SET NOT NULL;
@@ -0,0 +1,6 @@
+-- A provider's lead time may be 0, so the default is NULL rather than 0.
+-- Providers that had 0 kept the default and get NULL.
+
+ALTER TABLE providers ALTER COLUMN min_lead_time_minutes DROP NOT NULL;
+ALTER TABLE providers ALTER COLUMN min_lead_time_minutes DROP DEFAULT;
+UPDATE providers SET min_lead_time_minutes = NULL WHERE min_lead_time_minutes = 0;
@@ -0,0 +1,11 @@
+-- Drop the initial schema, dependent tables first.
+
+DROP TABLE IF EXISTS cancellations;
+DROP TABLE IF EXISTS reservation_slot;
+DROP TABLE IF EXISTS reservations;
+DROP TABLE IF EXISTS appointment_type;
+DROP TABLE IF EXISTS slots;
+DROP TABLE IF EXISTS availability_rule_exception;
+DROP TABLE IF EXISTS availability_rule;
+DROP TABLE IF EXISTS availability;
+DROP TABLE IF EXISTS providers;
@@ -0,0 +1,108 @@
+-- Initial schema. Databases set up by GORM's AutoMigrate before the migrations
+-- existed are not upgraded in place, their data is copied into a new database.
+
+CREATE TABLE providers (
+    id TEXT PRIMARY KEY,                             -- Unique identifier for the provider
+    name TEXT NOT NULL DEFAULT '',                   -- Provider's name
+    slot_duration_minutes INTEGER NOT NULL DEFAULT 0, -- Slot length, 0 means 15 minutes
+    buffer_before_minutes INTEGER NOT NULL DEFAULT 0, -- Free time before each slot
+    buffer_after_minutes INTEGER NOT NULL DEFAULT 0,  -- Free time after each slot
+    min_lead_time_minutes INTEGER NOT NULL DEFAULT 0, -- Booking lead time, 0 means 24 hours
+    time_zone TEXT NOT NULL DEFAULT ''               -- IANA time zone, empty means UTC
+);
+
+CREATE TABLE availability (
+    id TEXT PRIMARY KEY,                             -- Unique identifier for availability
+    provider_id TEXT NOT NULL REFERENCES providers (id),
+    rule_id TEXT NOT NULL DEFAULT '',                -- Rule the availability was materialized from
+    start_time DATETIME NOT NULL,                    -- Start time of availability, UTC
+    end_time DATETIME NOT NULL,                      -- End time of availability, UTC
+    UNIQUE (provider_id, start_time, end_time)       -- No duplicate availability windows
+);
+
+CREATE TABLE availability_rule (
+    id TEXT PRIMARY KEY,                             -- Unique identifier for the rule
+    provider_id TEXT NOT NULL REFERENCES providers (id),
+    weekdays TEXT NOT NULL,                          -- Comma-separated weekday names
+    start_time TEXT NOT NULL,                        -- Daily start time (HH:MM)
+    end_time TEXT NOT NULL,                          -- Daily end time (HH:MM)
+    start_date TEXT NOT NULL,                        -- First day the rule applies (YYYY-MM-DD)
+    end_date TEXT NOT NULL DEFAULT ''                -- Optional last day the rule applies (YYYY-MM-DD)
+);
+
+CREATE TABLE availability_rule_exception (
+    id TEXT PRIMARY KEY,                             -- Unique identifier for the exception
+    rule_id TEXT NOT NULL REFERENCES availability_rule (id),
+    date TEXT NOT NULL                               -- Day the rule does not apply (YYYY-MM-DD)
+);
+
+CREATE TABLE slots (
+    id TEXT PRIMARY KEY,                             -- Unique identifier for the slot
+    availability_id TEXT NOT NULL REFERENCES availability (id),
+    provider_id TEXT NOT NULL,                       -- Redundant provider reference for easier querying
+    start_time DATETIME NOT NULL,                    -- Start time of the slot, UTC
+    end_time DATETIME NOT NULL,                      -- End time of the slot, UTC
+    status TEXT NOT NULL CHECK (status IN ('Available')), -- Reserved slots are moved to reservation_slot
+    UNIQUE (availability_id, start_time, end_time)   -- No duplicate slots
+);
+
+CREATE TABLE appointment_type (
+    id TEXT PRIMARY KEY,                             -- Unique identifier for the appointment type
+    provider_id TEXT NOT NULL REFERENCES providers (id),
+    name TEXT NOT NULL,                              -- Name shown to clients
+    duration_minutes INTEGER NOT NULL,               -- Length of the appointment
+    color TEXT NOT NULL DEFAULT '',                  -- Display color
+    description TEXT NOT NULL DEFAULT ''             -- Free-form description
+);
+
+CREATE TABLE reservations (
+    id TEXT PRIMARY KEY,                             -- Unique identifier for the reservation
+    slot_id TEXT NOT NULL,                           -- First slot held, no longer in slots while held
+    client_id TEXT NOT NULL,                         -- Client making the reservation
+    availability_id TEXT NOT NULL REFERENCES availability (id),
+    appointment_type_id TEXT NOT NULL DEFAULT '',    -- Optional appointment type being booked
+    start_time DATETIME NOT NULL,                    -- Start time of the reservation, UTC
+    end_time DATETIME NOT NULL,                      -- End time of the reservation, UTC
+    provider_id TEXT NOT NULL REFERENCES providers (id),
+    status TEXT NOT NULL CHECK (status IN ('Reserved', 'Confirmed')),
+    reservation_expiry DATETIME                      -- When an unconfirmed hold expires
+);
+
+CREATE TABLE reservation_slot (
+    reservation_id TEXT NOT NULL REFERENCES reservations (id),
+    slot_id TEXT NOT NULL,                           -- Slot held by the reservation
+    availability_id TEXT NOT NULL,                   -- Availability the slot belongs to
+    provider_id TEXT NOT NULL,                       -- Provider the slot belongs to
+    start_time DATETIME NOT NULL,                    -- Start time of the slot, UTC
+    end_time DATETIME NOT NULL,                      -- End time of the slot, UTC
+    PRIMARY KEY (reservation_id, slot_id)
+);
+
+CREATE TABLE cancellations (
+    id TEXT PRIMARY KEY,                             -- Unique identifier for the cancellation
+    reservation_id TEXT NOT NULL,                    -- Cancelled reservation, deleted afterwards
+    slot_id TEXT NOT NULL,                           -- First slot returned to the pool
+    client_id TEXT NOT NULL,                         -- Client the reservation belonged to
+    provider_id TEXT NOT NULL REFERENCES providers (id),
+    start_time DATETIME NOT NULL,                    -- Start time of the cancelled reservation, UTC
+    end_time DATETIME NOT NULL,                      -- End time of the cancelled reservation, UTC
+    cancelled_by TEXT NOT NULL,                      -- Client or provider who cancelled
+    reason TEXT NOT NULL DEFAULT '',                 -- Free-form cancellation reason
+    cancelled_at DATETIME NOT NULL                   -- When the cancellation happened, UTC
+);
+
+CREATE INDEX idx_availability_provider_id ON availability (provider_id);
+CREATE INDEX idx_availability_rule_id ON availability (rule_id);
+CREATE INDEX idx_availability_rule_provider_id ON availability_rule (provider_id);
+CREATE INDEX idx_availability_rule_exception_rule_id ON availability_rule_exception (rule_id);
+CREATE INDEX idx_slots_availability_id ON slots (availability_id);
+CREATE INDEX idx_slots_provider_id ON slots (provider_id);
+CREATE INDEX idx_appointment_type_provider_id ON appointment_type (provider_id);
+CREATE INDEX idx_reservations_slot_id ON reservations (slot_id);
+CREATE INDEX idx_reservations_client_id ON reservations (client_id);
+CREATE INDEX idx_reservations_availability_id ON reservations (availability_id);
+CREATE INDEX idx_reservations_appointment_type_id ON reservations (appointment_type_id);
+CREATE INDEX idx_reservations_provider_id ON reservations (provider_id);
+CREATE INDEX idx_cancellations_reservation_id ON cancellations (reservation_id);
+CREATE INDEX idx_cancellations_client_id ON cancellations (client_id);
+CREATE INDEX idx_cancellations_provider_id ON cancellations (provider_id);
@@ -0,0 +1,13 @@
+-- Clients who book reservations. Reservations keep their client_id without a
+-- foreign key, so reservations made before clients were registered remain.
+
+CREATE TABLE IF NOT EXISTS clients (
+    id TEXT PRIMARY KEY,                             -- Unique identifier for the client
+    name TEXT NOT NULL DEFAULT '',                   -- Client's name
+    email TEXT NOT NULL DEFAULT '',                  -- Contact email
+    phone TEXT NOT NULL DEFAULT '',                  -- Contact phone number
+    date_of_birth TEXT NOT NULL DEFAULT '',          -- Date of birth (YYYY-MM-DD)
+    external_id TEXT NOT NULL DEFAULT ''             -- Patient identifier in an external system
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_clients_external_id ON clients (external_id) WHERE external_id <> '';
@@ -0,0 +1,3 @@
+-- Drop the provider active flag.
+
+ALTER TABLE providers DROP COLUMN active;
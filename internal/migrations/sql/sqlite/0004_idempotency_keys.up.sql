@@ -0,0 +1,11 @@
+-- Requests made with an idempotency key, and the responses replayed to their
+-- retries until they expire.
+
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    id TEXT PRIMARY KEY,                             -- Method, caller and key
+    request_hash TEXT NOT NULL,                      -- SHA-256 of the request without its key
+    response BLOB,                                   -- Serialized response, NULL while in progress
+    expires_at DATETIME NOT NULL                     -- When the key can be reused, UTC
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys (expires_at);
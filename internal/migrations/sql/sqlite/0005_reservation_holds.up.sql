@@ -0,0 +1,5 @@
+-- Holds are confirmed and extended with a token returned by ReserveSlot. Holds
+-- made before have no token and are left to expire.
+
+ALTER TABLE reservations ADD COLUMN hold_token_hash TEXT NOT NULL DEFAULT ''; -- SHA-256 of the hold token
+ALTER TABLE reservations ADD COLUMN hold_extensions INTEGER NOT NULL DEFAULT 0; -- Times the hold was extended
@@ -0,0 +1,21 @@
+-- Clients waiting for a provider's slot to free up, and the holds offered to
+-- them.
+
+CREATE TABLE IF NOT EXISTS waitlist_entry (
+    id TEXT PRIMARY KEY,                             -- Unique identifier for the entry
+    client_id TEXT NOT NULL,                         -- Client waiting
+    provider_id TEXT NOT NULL REFERENCES providers (id),
+    appointment_type_id TEXT NOT NULL DEFAULT '',    -- Optional appointment type to book
+    start_date TEXT NOT NULL,                        -- First acceptable day (YYYY-MM-DD)
+    end_date TEXT NOT NULL,                          -- Last acceptable day (YYYY-MM-DD)
+    weekdays TEXT NOT NULL DEFAULT '',               -- Optional acceptable weekdays, e.g. "Monday,Friday"
+    earliest_time TEXT NOT NULL DEFAULT '',          -- Optional earliest start time (HH:MM)
+    latest_time TEXT NOT NULL DEFAULT '',            -- Optional latest start time (HH:MM)
+    status TEXT NOT NULL CHECK (status IN ('Waiting', 'Offered', 'Accepted', 'Expired', 'Declined')),
+    reservation_id TEXT NOT NULL DEFAULT '',         -- Hold offered to the client
+    created_at DATETIME NOT NULL                     -- When the client joined, UTC
+);
+
+CREATE INDEX IF NOT EXISTS idx_waitlist_entry_client_id ON waitlist_entry (client_id);
+CREATE INDEX IF NOT EXISTS idx_waitlist_entry_provider_id ON waitlist_entry (provider_id);
+CREATE INDEX IF NOT EXISTS idx_waitlist_entry_reservation_id ON waitlist_entry (reservation_id);
@@ -0,0 +1,7 @@
+-- Go back to 0 for the default lead time. Providers with a lead time of 0 get
+-- the default.
+
+ALTER TABLE providers ADD COLUMN lead_time_minutes INTEGER NOT NULL DEFAULT 0; -- Booking lead time, 0 means 24 hours
+UPDATE providers SET lead_time_minutes = COALESCE(min_lead_time_minutes, 0);
+ALTER TABLE providers DROP COLUMN min_lead_time_minutes;
+ALTER TABLE providers RENAME COLUMN lead_time_minutes TO min_lead_time_minutes;
@@ -0,0 +1,8 @@
+-- A provider's lead time may be 0, so the default is NULL rather than 0.
+-- Providers that had 0 kept the default and get NULL. SQLite cannot drop NOT
+-- NULL from a column, so the column is replaced.
+
+ALTER TABLE providers ADD COLUMN lead_time_minutes INTEGER; -- Booking lead time, NULL means the server default
+UPDATE providers SET lead_time_minutes = NULLIF(min_lead_time_minutes, 0);
+ALTER TABLE providers DROP COLUMN min_lead_time_minutes;
+ALTER TABLE providers RENAME COLUMN lead_time_minutes TO min_lead_time_minutes;
@@ -3,13 +3,30 @@ package models
 import "time"
 
 type Provider struct {
-	ID   string `gorm:"primaryKey"`
-	Name string
+	ID                  string `gorm:"primaryKey"`
+	Name                string
+	SlotDurationMinutes int    // Length of each slot, 0 means the default of 15 minutes
+	BufferBeforeMinutes int    // Free time kept before each slot
+	BufferAfterMinutes  int    // Free time kept after each slot
+	MinLeadTimeMinutes  *int   // How far ahead slots must be booked, nil means the configured default
+	TimeZone            string // IANA time zone, empty means UTC
+	Active              bool   // Inactive providers cannot be booked and show no slots
+}
+
+// Client is a patient who books reservations.
+type Client struct {
+	ID          string `gorm:"primaryKey"`
+	Name        string
+	Email       string
+	Phone       string
+	DateOfBirth string // YYYY-MM-DD, empty if unknown
+	ExternalID  string // Patient identifier in an external system, such as an EHR
 }
 
 type Availability struct {
 	ID         string `gorm:"primaryKey"`
 	ProviderID string `gorm:"index"`
+	RuleID     string `gorm:"index"` // Set when materialized from an AvailabilityRule
 	StartTime  time.Time
 	EndTime    time.Time
 	Provider   Provider `gorm:"foreignKey:ProviderID"`
@@ -20,6 +37,34 @@ func (Availability) TableName() string {
 	return "availability"
 }
 
+type AvailabilityRule struct {
+	ID         string                      `gorm:"primaryKey"`
+	ProviderID string                      `gorm:"index"`
+	Weekdays   string                      // Comma-separated weekday names, e.g. "Monday,Friday"
+	StartTime  string                      // Daily start time, HH:MM
+	EndTime    string                      // Daily end time, HH:MM
+	StartDate  string                      // First day the rule applies, YYYY-MM-DD
+	EndDate    string                      // Optional last day the rule applies, YYYY-MM-DD
+	Exceptions []AvailabilityRuleException `gorm:"foreignKey:RuleID"`
+	Provider   Provider                    `gorm:"foreignKey:ProviderID"`
+}
+
+// Specify the singular table name for AvailabilityRule
+func (AvailabilityRule) TableName() string {
+	return "availability_rule"
+}
+
+type AvailabilityRuleException struct {
+	ID     string `gorm:"primaryKey"`
+	RuleID string `gorm:"index"`
+	Date   string // Day the rule does not apply, YYYY-MM-DD
+}
+
+// Specify the singular table name for AvailabilityRuleException
+func (AvailabilityRuleException) TableName() string {
+	return "availability_rule_exception"
+}
+
 type Slot struct {
 	ID             string `gorm:"primaryKey"`
 	AvailabilityID string `gorm:"index"`
@@ -30,15 +75,112 @@ type Slot struct {
 	ProviderID     string       `gorm:"index"`
 }
 
+// Precedes reports whether next directly follows s in the same availability
+// window, with at most maxGap of buffer time between them.
+func (s Slot) Precedes(next Slot, maxGap time.Duration) bool {
+	if s.AvailabilityID != next.AvailabilityID || next.StartTime.Before(s.EndTime) {
+		return false
+	}
+	return next.StartTime.Sub(s.EndTime) <= maxGap
+}
+
+type AppointmentType struct {
+	ID              string `gorm:"primaryKey"`
+	ProviderID      string `gorm:"index"`
+	Name            string
+	DurationMinutes int
+	Color           string // Display color, e.g. "#4caf50"
+	Description     string
+	Provider        Provider `gorm:"foreignKey:ProviderID"`
+}
+
+// Specify the singular table name for AppointmentType
+func (AppointmentType) TableName() string {
+	return "appointment_type"
+}
+
 type Reservation struct {
 	ID                string `gorm:"primaryKey"`
-	SlotID            string `gorm:"index"`
+	SlotID            string `gorm:"index"` // First slot held by the reservation
 	ClientID          string `gorm:"index"`
 	AvailabilityID    string `gorm:"index"`
+	AppointmentTypeID string `gorm:"index"`
 	StartTime         time.Time
 	EndTime           time.Time
 	ProviderID        string `gorm:"index"`
 	Status            string // Pending, Confirmed
 	ReservationExpiry *time.Time
-	Slot              Slot `gorm:"foreignKey:SlotID"`
+	HoldTokenHash     string            // SHA-256 of the token needed to confirm or extend the hold, hex encoded
+	HoldExtensions    int               // How many times the hold was extended
+	Slot              Slot              `gorm:"foreignKey:SlotID"`
+	HeldSlots         []ReservationSlot `gorm:"foreignKey:ReservationID"`
+}
+
+// ReservationSlot is a slot held by a reservation, kept so that it can be
+// returned to the pool when the reservation is released.
+type ReservationSlot struct {
+	ReservationID  string `gorm:"primaryKey"`
+	SlotID         string `gorm:"primaryKey"`
+	AvailabilityID string
+	ProviderID     string
+	StartTime      time.Time
+	EndTime        time.Time
+}
+
+// Specify the singular table name for ReservationSlot
+func (ReservationSlot) TableName() string {
+	return "reservation_slot"
+}
+
+type Cancellation struct {
+	ID            string `gorm:"primaryKey"`
+	ReservationID string `gorm:"index"`
+	SlotID        string
+	ClientID      string `gorm:"index"`
+	ProviderID    string `gorm:"index"`
+	StartTime     time.Time
+	EndTime       time.Time
+	CancelledBy   string
+	Reason        string
+	CancelledAt   time.Time
+}
+
+// IdempotencyKey is a request made with an idempotency key and the response it
+// got, kept so that a retry with the same key gets the same response.
+type IdempotencyKey struct {
+	ID          string    `gorm:"primaryKey"` // Method, caller and key, e.g. "ReserveSlot:client:client_123:key"
+	RequestHash string    // SHA-256 of the request without its key, hex encoded
+	Response    []byte    // Serialized response, empty while the request is in progress
+	ExpiresAt   time.Time `gorm:"index"`
+}
+
+// Statuses of a WaitlistEntry.
+const (
+	WaitlistWaiting  = "Waiting"  // Waiting for a slot to free up
+	WaitlistOffered  = "Offered"  // Holding an offered slot until the offer expires
+	WaitlistAccepted = "Accepted" // The offer was accepted and the reservation confirmed
+	WaitlistExpired  = "Expired"  // The offer was not accepted in time, or the entry can no longer be served
+	WaitlistDeclined = "Declined" // The offered reservation was cancelled
+)
+
+// WaitlistEntry is a client waiting for a provider's slot to free up. When one
+// does, the oldest matching entry is offered it as an exclusive hold.
+type WaitlistEntry struct {
+	ID                string `gorm:"primaryKey"`
+	ClientID          string `gorm:"index"`
+	ProviderID        string `gorm:"index"`
+	AppointmentTypeID string // Optional appointment type to book
+	StartDate         string // First acceptable day, YYYY-MM-DD in the provider's time zone
+	EndDate           string // Last acceptable day, YYYY-MM-DD in the provider's time zone
+	Weekdays          string // Optional comma-separated weekday names, e.g. "Monday,Friday"
+	EarliestTime      string // Optional earliest start time, HH:MM in the provider's time zone
+	LatestTime        string // Optional latest start time, HH:MM in the provider's time zone
+	Status            string // Waiting, Offered, Accepted, Expired or Declined
+	ReservationID     string `gorm:"index"` // Hold offered to the client
+	CreatedAt         time.Time
+}
+
+// Specify the singular table name for WaitlistEntry
+func (WaitlistEntry) TableName() string {
+	return "waitlist_entry"
 }
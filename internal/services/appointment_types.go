@@ -0,0 +1,158 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	pb "github.com/manueldelreal/health-reservation-system/api"
+	"github.com/manueldelreal/health-reservation-system/internal/auth"
+	"github.com/manueldelreal/health-reservation-system/internal/models"
+)
+
+func (s *ReservationService) CreateAppointmentType(ctx context.Context, req *pb.CreateAppointmentTypeRequest) (*pb.CreateAppointmentTypeResponse, error) {
+	if err := authorize(ctx, auth.Provider(req.ProviderId)); err != nil {
+		return nil, err
+	}
+
+	// Validate that the provider exists
+	_, err := s.Repo.GetProvider(ctx, req.ProviderId)
+	if err != nil {
+		return nil, lookupError(err, "provider", req.ProviderId)
+	}
+
+	if req.Name == "" {
+		return nil, invalidArgument("name", "name is required")
+	}
+	if req.DurationMinutes <= 0 {
+		return nil, invalidArgument("duration_minutes", "duration must be positive")
+	}
+
+	appointmentType := models.AppointmentType{
+		ID:              generateID(),
+		ProviderID:      req.ProviderId,
+		Name:            req.Name,
+		DurationMinutes: int(req.DurationMinutes),
+		Color:           req.Color,
+		Description:     req.Description,
+	}
+
+	err = s.Repo.CreateAppointmentType(ctx, &appointmentType)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	return &pb.CreateAppointmentTypeResponse{
+		Id:      appointmentType.ID,
+		Message: "Appointment type created successfully",
+	}, nil
+}
+
+func (s *ReservationService) ListAppointmentTypes(ctx context.Context, req *pb.ListAppointmentTypesRequest) (*pb.ListAppointmentTypesResponse, error) {
+	if err := authenticated(ctx); err != nil {
+		return nil, err
+	}
+
+	if req.ProviderId == "" {
+		return nil, invalidArgument("provider_id", "provider_id is required")
+	}
+
+	appointmentTypes, err := s.Repo.GetAppointmentTypes(ctx, req.ProviderId)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	// Convert database results to protobuf response
+	var pbAppointmentTypes []*pb.AppointmentType
+	for _, appointmentType := range appointmentTypes {
+		pbAppointmentTypes = append(pbAppointmentTypes, &pb.AppointmentType{
+			Id:              appointmentType.ID,
+			ProviderId:      appointmentType.ProviderID,
+			Name:            appointmentType.Name,
+			DurationMinutes: int32(appointmentType.DurationMinutes),
+			Color:           appointmentType.Color,
+			Description:     appointmentType.Description,
+		})
+	}
+
+	return &pb.ListAppointmentTypesResponse{AppointmentTypes: pbAppointmentTypes}, nil
+}
+
+func (s *ReservationService) DeleteAppointmentType(ctx context.Context, req *pb.DeleteAppointmentTypeRequest) (*pb.DeleteAppointmentTypeResponse, error) {
+	if err := authenticated(ctx); err != nil {
+		return nil, err
+	}
+
+	// Only the owning provider may delete an appointment type
+	appointmentType, err := s.Repo.GetAppointmentType(ctx, req.Id, "")
+	if err != nil {
+		return nil, lookupError(err, "appointment type", req.Id)
+	}
+	if err := authorize(ctx, auth.Provider(appointmentType.ProviderID)); err != nil {
+		return nil, err
+	}
+
+	err = s.Repo.DeleteAppointmentType(ctx, req.Id)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	return &pb.DeleteAppointmentTypeResponse{Message: "Appointment type deleted successfully"}, nil
+}
+
+// bookingShape returns how many consecutive slots of a provider a booking needs,
+// and the largest gap allowed between two of them. Without an appointment type
+// a booking takes a single slot.
+func (s *ReservationService) bookingShape(ctx context.Context, providerID, appointmentTypeID string) (int, time.Duration, error) {
+	provider, err := s.Repo.GetProvider(ctx, providerID)
+	if err != nil {
+		return 0, 0, lookupError(err, "provider", providerID)
+	}
+	settings := s.providerSettings(provider)
+	maxGap := settings.bufferBefore + settings.bufferAfter
+
+	if appointmentTypeID == "" {
+		return 1, maxGap, nil
+	}
+
+	appointmentType, err := s.Repo.GetAppointmentType(ctx, appointmentTypeID, providerID)
+	if err != nil {
+		return 0, 0, lookupError(err, "appointment type", appointmentTypeID)
+	}
+
+	// Round up to cover the whole appointment
+	duration := time.Duration(appointmentType.DurationMinutes) * time.Minute
+	count := int((duration + settings.duration - 1) / settings.duration)
+	return count, maxGap, nil
+}
+
+// availableRuns returns the slots that start a run of count consecutive slots,
+// with their end time moved to the end of the run.
+func availableRuns(slots []models.Slot, count int, maxGap time.Duration) []models.Slot {
+	// Walk each availability window in order
+	ordered := append([]models.Slot(nil), slots...)
+	sort.Slice(ordered, func(i, j int) bool {
+		if ordered[i].AvailabilityID != ordered[j].AvailabilityID {
+			return ordered[i].AvailabilityID < ordered[j].AvailabilityID
+		}
+		return ordered[i].StartTime.Before(ordered[j].StartTime)
+	})
+
+	var runs []models.Slot
+	for i := 0; i+count <= len(ordered); i++ {
+		end := i
+		for end < i+count-1 && ordered[end].Precedes(ordered[end+1], maxGap) {
+			end++
+		}
+		if end == i+count-1 {
+			run := ordered[i]
+			run.EndTime = ordered[end].EndTime
+			runs = append(runs, run)
+		}
+	}
+
+	sort.Slice(runs, func(i, j int) bool {
+		return runs[i].StartTime.Before(runs[j].StartTime)
+	})
+	return runs
+}
@@ -0,0 +1,104 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/twitchtv/twirp"
+
+	pb "github.com/manueldelreal/health-reservation-system/api"
+)
+
+// createAppointmentType adds an appointment type to a provider's catalog and
+// returns its ID.
+func (b *testBooking) createAppointmentType(t *testing.T, providerID string, durationMinutes int32) string {
+	t.Helper()
+	resp, err := b.service.CreateAppointmentType(asProvider(providerID), &pb.CreateAppointmentTypeRequest{
+		ProviderId:      providerID,
+		Name:            "Consultation",
+		DurationMinutes: durationMinutes,
+	})
+	if err != nil {
+		t.Fatalf("CreateAppointmentType: %v", err)
+	}
+	return resp.Id
+}
+
+func TestAppointmentTypeCatalog(t *testing.T) {
+	b := newTestBooking(t)
+	typeID := b.createAppointmentType(t, "p1", 60)
+
+	_, err := b.service.CreateAppointmentType(asProvider("p2"), &pb.CreateAppointmentTypeRequest{ProviderId: "p1", Name: "Checkup", DurationMinutes: 30})
+	wantCode(t, err, twirp.PermissionDenied)
+	_, err = b.service.CreateAppointmentType(asProvider("p1"), &pb.CreateAppointmentTypeRequest{ProviderId: "p1", Name: "Checkup"})
+	wantCode(t, err, twirp.InvalidArgument)
+
+	list, err := b.service.ListAppointmentTypes(asClient("c1"), &pb.ListAppointmentTypesRequest{ProviderId: "p1"})
+	if err != nil {
+		t.Fatalf("ListAppointmentTypes: %v", err)
+	}
+	if len(list.AppointmentTypes) != 1 || list.AppointmentTypes[0].Id != typeID || list.AppointmentTypes[0].DurationMinutes != 60 {
+		t.Fatalf("got appointment types %v, want the 60-minute %s", list.AppointmentTypes, typeID)
+	}
+
+	_, err = b.service.DeleteAppointmentType(asProvider("p2"), &pb.DeleteAppointmentTypeRequest{Id: typeID})
+	wantCode(t, err, twirp.PermissionDenied)
+	if _, err := b.service.DeleteAppointmentType(asProvider("p1"), &pb.DeleteAppointmentTypeRequest{Id: typeID}); err != nil {
+		t.Fatalf("DeleteAppointmentType: %v", err)
+	}
+	list, err = b.service.ListAppointmentTypes(asClient("c1"), &pb.ListAppointmentTypesRequest{ProviderId: "p1"})
+	if err != nil {
+		t.Fatalf("ListAppointmentTypes after deleting: %v", err)
+	}
+	if len(list.AppointmentTypes) != 0 {
+		t.Fatalf("got appointment types %v after deleting, want none", list.AppointmentTypes)
+	}
+}
+
+func TestReserveAppointmentTypeClaimsConsecutiveSlots(t *testing.T) {
+	b := newTestBooking(t)
+	typeID := b.createAppointmentType(t, "p1", 60)
+
+	// A 60-minute appointment fits at 09:00, 09:30 and 10:00, and each start
+	// time ends an hour later
+	starts, err := b.service.GetAvailableSlots(asClient("c1"), &pb.GetAvailableSlotsRequest{ProviderId: "p1", Date: b.day, AppointmentTypeId: typeID})
+	if err != nil {
+		t.Fatalf("GetAvailableSlots: %v", err)
+	}
+	if len(starts.Slots) != 3 {
+		t.Fatalf("got %d start times, want 3", len(starts.Slots))
+	}
+	for _, slot := range starts.Slots {
+		start, _ := time.Parse(time.RFC3339, slot.StartTime)
+		end, _ := time.Parse(time.RFC3339, slot.EndTime)
+		if end.Sub(start) != time.Hour {
+			t.Fatalf("start time %s ends at %s, want an hour later", slot.StartTime, slot.EndTime)
+		}
+	}
+
+	_, err = b.service.ReserveSlot(asClient("c1"), &pb.ReserveSlotRequest{SlotId: starts.Slots[0].Id, ClientId: "c1", AppointmentTypeId: typeID})
+	if err != nil {
+		t.Fatalf("ReserveSlot: %v", err)
+	}
+
+	// The booking took the 09:00 and 09:30 slots
+	left := b.slots(t, "p1")
+	if len(left) != 2 {
+		t.Fatalf("got %d slots left, want 2", len(left))
+	}
+
+	// Only one hour is left, so the last slot cannot start an appointment
+	_, err = b.service.ReserveSlot(asClient("c2"), &pb.ReserveSlotRequest{SlotId: left[1], ClientId: "c2", AppointmentTypeId: typeID})
+	wantCode(t, err, twirp.FailedPrecondition)
+	if _, err := b.service.ReserveSlot(asClient("c2"), &pb.ReserveSlotRequest{SlotId: left[0], ClientId: "c2", AppointmentTypeId: typeID}); err != nil {
+		t.Fatalf("ReserveSlot of the last hour: %v", err)
+	}
+
+	// Another provider's appointment type cannot be booked with p1
+	otherID := b.createAppointmentType(t, "p2", 30)
+	_, err = b.service.ReserveSlot(asClient("c1"), &pb.ReserveSlotRequest{SlotId: b.slots(t, "p2")[0], ClientId: "c1", AppointmentTypeId: typeID})
+	wantCode(t, err, twirp.NotFound)
+	if _, err := b.service.ReserveSlot(asClient("c1"), &pb.ReserveSlotRequest{SlotId: b.slots(t, "p2")[0], ClientId: "c1", AppointmentTypeId: otherID}); err != nil {
+		t.Fatalf("ReserveSlot with p2's appointment type: %v", err)
+	}
+}
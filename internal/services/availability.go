@@ -0,0 +1,203 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/twitchtv/twirp"
+
+	pb "github.com/manueldelreal/health-reservation-system/api"
+	"github.com/manueldelreal/health-reservation-system/internal/auth"
+	"github.com/manueldelreal/health-reservation-system/internal/models"
+	"github.com/manueldelreal/health-reservation-system/internal/storage"
+)
+
+func (s *ReservationService) UpdateAvailability(ctx context.Context, req *pb.UpdateAvailabilityRequest) (*pb.UpdateAvailabilityResponse, error) {
+	if err := authorize(ctx, auth.Provider(req.ProviderId)); err != nil {
+		return nil, err
+	}
+
+	provider, availability, err := s.editableAvailability(ctx, req.ProviderId, req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	// The window can only shrink
+	newStart, err := time.Parse(time.RFC3339, req.NewStartTime)
+	if err != nil {
+		return nil, invalidArgument("new_start_time", "invalid new start time format")
+	}
+	newEnd, err := time.Parse(time.RFC3339, req.NewEndTime)
+	if err != nil {
+		return nil, invalidArgument("new_end_time", "invalid new end time format")
+	}
+	if !newStart.Before(newEnd) {
+		return nil, invalidArgument("new_end_time", "new end time must be after new start time")
+	}
+	if newStart.Before(availability.StartTime) || newEnd.After(availability.EndTime) {
+		return nil, invalidArgument("new_start_time", "availability can only be shrunk, use SetAvailability to add time")
+	}
+
+	// Reservations outside the new times are in the way
+	reservations, err := s.Repo.GetReservationsByAvailability(ctx, availability.ID)
+	if err != nil {
+		return nil, storageError(err)
+	}
+	var conflicts []models.Reservation
+	for _, reservation := range reservations {
+		if reservation.StartTime.Before(newStart) || reservation.EndTime.After(newEnd) {
+			conflicts = append(conflicts, reservation)
+		}
+	}
+	cancellations, err := forcedCancellations(ctx, conflicts, req.Force, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cancel them and shrink the window together
+	recorded, err := s.Repo.ResizeAvailability(ctx, availability.ID, newStart, newEnd, cancellations)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	return &pb.UpdateAvailabilityResponse{
+		Message:               "Availability updated successfully",
+		CancelledReservations: s.cancelledDetails(provider, conflicts, recorded),
+	}, nil
+}
+
+func (s *ReservationService) RemoveAvailability(ctx context.Context, req *pb.RemoveAvailabilityRequest) (*pb.RemoveAvailabilityResponse, error) {
+	if err := authorize(ctx, auth.Provider(req.ProviderId)); err != nil {
+		return nil, err
+	}
+
+	provider, availability, err := s.editableAvailability(ctx, req.ProviderId, req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every reservation in the window is in the way
+	reservations, err := s.Repo.GetReservationsByAvailability(ctx, availability.ID)
+	if err != nil {
+		return nil, storageError(err)
+	}
+	cancellations, err := forcedCancellations(ctx, reservations, req.Force, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cancel them and remove the window together
+	recorded, err := s.Repo.RemoveAvailability(ctx, availability.ID, cancellations)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	return &pb.RemoveAvailabilityResponse{
+		Message:               "Availability removed successfully",
+		CancelledReservations: s.cancelledDetails(provider, reservations, recorded),
+	}, nil
+}
+
+// editableAvailability fetches the provider and the availability window with
+// exactly the given start and end times, and checks that the window may be
+// changed: it must not have started, and must not come from an availability
+// rule, which would recreate it.
+func (s *ReservationService) editableAvailability(ctx context.Context, providerID, startTime, endTime string) (models.Provider, models.Availability, error) {
+	provider, err := s.Repo.GetProvider(ctx, providerID)
+	if err != nil {
+		return models.Provider{}, models.Availability{}, lookupError(err, "provider", providerID)
+	}
+
+	start, err := time.Parse(time.RFC3339, startTime)
+	if err != nil {
+		return models.Provider{}, models.Availability{}, invalidArgument("start_time", "invalid start time format")
+	}
+	end, err := time.Parse(time.RFC3339, endTime)
+	if err != nil {
+		return models.Provider{}, models.Availability{}, invalidArgument("end_time", "invalid end time format")
+	}
+
+	availability, err := s.Repo.FindAvailability(ctx, providerID, start, end)
+	if errors.Is(err, storage.ErrNotFound) {
+		return models.Provider{}, models.Availability{}, twirp.NotFoundError("availability not found")
+	}
+	if err != nil {
+		return models.Provider{}, models.Availability{}, storageError(err)
+	}
+
+	if availability.RuleID != "" {
+		return models.Provider{}, models.Availability{}, failedPrecondition("availability comes from an availability rule, add an exception to the rule instead").
+			WithMeta("availability_rule_id", availability.RuleID)
+	}
+	if !availability.StartTime.After(time.Now()) {
+		return models.Provider{}, models.Availability{}, failedPrecondition("availability has already started")
+	}
+	return provider, availability, nil
+}
+
+// forcedCancellations returns the cancellations of the reservations in the way
+// of an availability change when force is set, which ignore the cancellation
+// cutoff. Without force, any reservation fails the change with their IDs in
+// the "reservation_ids" metadata.
+func forcedCancellations(ctx context.Context, reservations []models.Reservation, force bool, reason string) ([]models.Cancellation, error) {
+	if len(reservations) == 0 {
+		return nil, nil
+	}
+	if !force {
+		ids := make([]string, 0, len(reservations))
+		for _, reservation := range reservations {
+			ids = append(ids, reservation.ID)
+		}
+		return nil, failedPrecondition("availability has reservations, set force to cancel them").
+			WithMeta("reservation_ids", strings.Join(ids, ","))
+	}
+
+	if reason == "" {
+		reason = "Availability removed"
+	}
+	now := time.Now().UTC()
+	cancellations := make([]models.Cancellation, 0, len(reservations))
+	for _, reservation := range reservations {
+		cancellations = append(cancellations, models.Cancellation{
+			ID:            generateID(),
+			ReservationID: reservation.ID,
+			CancelledBy:   callerName(ctx),
+			Reason:        reason,
+			CancelledAt:   now,
+		})
+	}
+	return cancellations, nil
+}
+
+// cancelledDetails counts the cancellations recorded by an availability change
+// and returns the details of their reservations. Reservations cancelled or
+// expired in the meantime are left out.
+func (s *ReservationService) cancelledDetails(provider models.Provider, reservations []models.Reservation, recorded []models.Cancellation) []*pb.ReservationDetails {
+	byID := make(map[string]models.Reservation, len(reservations))
+	for _, reservation := range reservations {
+		byID[reservation.ID] = reservation
+	}
+	// The time zone was checked when the provider was saved
+	loc, err := loadLocation(provider.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	var cancelled []*pb.ReservationDetails
+	for _, cancellation := range recorded {
+		s.Metrics.ReservationCancelled()
+		reservation := byID[cancellation.ReservationID]
+		cancelled = append(cancelled, &pb.ReservationDetails{
+			ReservationId:     reservation.ID,
+			ClientId:          reservation.ClientID,
+			ProviderId:        reservation.ProviderID,
+			Status:            reservation.Status,
+			StartTime:         reservation.StartTime.In(loc).Format(time.RFC3339),
+			EndTime:           reservation.EndTime.In(loc).Format(time.RFC3339),
+			AppointmentTypeId: reservation.AppointmentTypeID,
+		})
+	}
+	return cancelled
+}
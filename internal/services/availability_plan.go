@@ -0,0 +1,386 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	pb "github.com/manueldelreal/health-reservation-system/api"
+	"github.com/manueldelreal/health-reservation-system/internal/models"
+	"github.com/manueldelreal/health-reservation-system/internal/storage"
+)
+
+// Overlap modes of SetAvailability, for windows that overlap existing
+// availability or each other.
+const (
+	// overlapReject rejects the overlapping window.
+	overlapReject = "reject"
+
+	// overlapMerge grows the windows it overlaps into a single window.
+	overlapMerge = "merge"
+
+	// overlapSplit only adds the parts that are not covered yet.
+	overlapSplit = "split"
+)
+
+// Outcomes of a SetAvailability time slot.
+const (
+	outcomeCreated   = "created"
+	outcomeMerged    = "merged"
+	outcomeSplit     = "split"
+	outcomeUnchanged = "unchanged"
+	outcomeRejected  = "rejected"
+	outcomeInvalid   = "invalid"
+	outcomeSkipped   = "skipped"
+)
+
+// interval is a span of time from start up to end.
+type interval struct {
+	start, end time.Time
+}
+
+func (i interval) overlaps(other interval) bool {
+	return i.start.Before(other.end) && other.start.Before(i.end)
+}
+
+func (i interval) equal(other interval) bool {
+	return i.start.Equal(other.start) && i.end.Equal(other.end)
+}
+
+// subtract returns the parts of i not covered by any of the others, in order.
+func (i interval) subtract(others []interval) []interval {
+	sort.Slice(others, func(a, b int) bool { return others[a].start.Before(others[b].start) })
+
+	var parts []interval
+	cursor := i.start
+	for _, other := range others {
+		if other.start.After(cursor) {
+			end := other.start
+			if end.After(i.end) {
+				end = i.end
+			}
+			if end.After(cursor) {
+				parts = append(parts, interval{cursor, end})
+			}
+		}
+		if other.end.After(cursor) {
+			cursor = other.end
+		}
+	}
+	if i.end.After(cursor) {
+		parts = append(parts, interval{cursor, i.end})
+	}
+	return parts
+}
+
+// plannedWindow is an availability window as SetAvailability will leave it.
+type plannedWindow struct {
+	availability models.Availability
+
+	// stored is set for windows already in the database, and grown for stored
+	// windows a merge changes.
+	stored bool
+	grown  bool
+
+	// absorbed are the stored windows merged into this one, and covered the
+	// spans that already have slots.
+	absorbed []string
+	covered  []interval
+}
+
+func (w *plannedWindow) interval() interval {
+	return interval{w.availability.StartTime, w.availability.EndTime}
+}
+
+// windowResult is what happened to a single time slot of the request.
+type windowResult struct {
+	outcome string
+	reason  string
+	windows []*plannedWindow
+}
+
+// availabilityPlan works out how the time slots of a SetAvailability request
+// change a provider's availability, before anything is saved. Time slots are
+// checked against the stored windows and the earlier time slots.
+type availabilityPlan struct {
+	providerID string
+	mode       string
+	settings   slotSettings
+	windows    []*plannedWindow
+	results    []*windowResult
+}
+
+// newAvailabilityPlan starts a plan from the provider's stored windows that the
+// request may overlap. New slots are split using the provider's settings.
+func newAvailabilityPlan(providerID, mode string, settings slotSettings, stored []models.Availability) *availabilityPlan {
+	plan := &availabilityPlan{providerID: providerID, mode: mode, settings: settings}
+	for _, availability := range stored {
+		window := &plannedWindow{availability: availability, stored: true}
+		window.covered = []interval{window.interval()}
+		plan.windows = append(plan.windows, window)
+	}
+	return plan
+}
+
+// add plans the next time slot of the request.
+func (p *availabilityPlan) add(span interval) {
+	result := &windowResult{}
+	p.results = append(p.results, result)
+
+	var overlapping []*plannedWindow
+	for _, window := range p.windows {
+		if window.interval().equal(span) {
+			result.outcome = outcomeUnchanged
+			result.reason = "availability already exists"
+			result.windows = []*plannedWindow{window}
+			return
+		}
+		if window.interval().overlaps(span) {
+			overlapping = append(overlapping, window)
+		}
+	}
+
+	if len(overlapping) == 0 {
+		result.outcome = outcomeCreated
+		result.windows = []*plannedWindow{p.newWindow(span)}
+		return
+	}
+
+	switch p.mode {
+	case overlapSplit:
+		var taken []interval
+		for _, window := range overlapping {
+			taken = append(taken, window.interval())
+		}
+		parts := span.subtract(taken)
+		if len(parts) == 0 {
+			result.outcome = outcomeUnchanged
+			result.reason = "already covered by existing availability"
+			return
+		}
+		if gap, uneven := p.unevenGap(parts); uneven {
+			result.outcome = outcomeRejected
+			result.reason = p.unevenReason(gap)
+			return
+		}
+		result.outcome = outcomeSplit
+		for _, part := range parts {
+			result.windows = append(result.windows, p.newWindow(part))
+		}
+
+	case overlapMerge:
+		// A span inside a single window leaves nothing to merge
+		if len(overlapping) == 1 && len(span.subtract([]interval{overlapping[0].interval()})) == 0 {
+			result.outcome = outcomeUnchanged
+			result.reason = "already covered by existing availability"
+			result.windows = overlapping
+			return
+		}
+		for _, window := range overlapping {
+			if window.availability.RuleID != "" {
+				result.outcome = outcomeRejected
+				result.reason = fmt.Sprintf("overlaps %s, which comes from availability rule %s and cannot be merged", describeWindow(window), window.availability.RuleID)
+				return
+			}
+		}
+
+		// The merged window only gets slots where it has none yet
+		extent := span
+		var covered []interval
+		for _, window := range overlapping {
+			if window.availability.StartTime.Before(extent.start) {
+				extent.start = window.availability.StartTime
+			}
+			if window.availability.EndTime.After(extent.end) {
+				extent.end = window.availability.EndTime
+			}
+			covered = append(covered, window.covered...)
+		}
+		if gap, uneven := p.unevenGap(extent.subtract(covered)); uneven {
+			result.outcome = outcomeRejected
+			result.reason = p.unevenReason(gap)
+			return
+		}
+		result.outcome = outcomeMerged
+		result.windows = []*plannedWindow{p.merge(span, overlapping)}
+
+	default:
+		result.outcome = outcomeRejected
+		result.reason = "overlaps " + describeWindow(overlapping[0])
+	}
+}
+
+// unevenGap returns the first of the parts that would get new slots but is not
+// a multiple of the slot step, so part of it would be left without slots.
+func (p *availabilityPlan) unevenGap(parts []interval) (interval, bool) {
+	for _, part := range parts {
+		if part.end.Sub(part.start)%p.settings.step() != 0 {
+			return part, true
+		}
+	}
+	return interval{}, false
+}
+
+// unevenReason explains why a time slot leaving an uneven gap was rejected.
+func (p *availabilityPlan) unevenReason(gap interval) string {
+	return fmt.Sprintf("the new part from %s to %s is not a multiple of the %s taken by each slot and its buffers",
+		gap.start.Format(time.RFC3339), gap.end.Format(time.RFC3339), formatDuration(p.settings.step()))
+}
+
+// addInvalid records the next time slot of the request as invalid.
+func (p *availabilityPlan) addInvalid(reason string) {
+	p.results = append(p.results, &windowResult{outcome: outcomeInvalid, reason: reason})
+}
+
+// newWindow plans a new window.
+func (p *availabilityPlan) newWindow(span interval) *plannedWindow {
+	window := &plannedWindow{availability: models.Availability{
+		ID:         generateID(),
+		ProviderID: p.providerID,
+		StartTime:  span.start,
+		EndTime:    span.end,
+	}}
+	p.windows = append(p.windows, window)
+	return window
+}
+
+// merge grows one of the overlapping windows, a stored one if there is any, to
+// cover the span and all the others, and drops the others.
+func (p *availabilityPlan) merge(span interval, overlapping []*plannedWindow) *plannedWindow {
+	survivor := overlapping[0]
+	for _, window := range overlapping {
+		if window.stored {
+			survivor = window
+			break
+		}
+	}
+
+	merged := make(map[*plannedWindow]bool)
+	for _, window := range overlapping {
+		if window.availability.StartTime.Before(span.start) {
+			span.start = window.availability.StartTime
+		}
+		if window.availability.EndTime.After(span.end) {
+			span.end = window.availability.EndTime
+		}
+		if window == survivor {
+			continue
+		}
+		merged[window] = true
+		if window.stored {
+			survivor.absorbed = append(survivor.absorbed, window.availability.ID)
+		}
+		survivor.absorbed = append(survivor.absorbed, window.absorbed...)
+		survivor.covered = append(survivor.covered, window.covered...)
+	}
+
+	survivor.availability.StartTime, survivor.availability.EndTime = span.start, span.end
+	survivor.grown = survivor.stored
+
+	// Earlier time slots now belong to the merged window
+	windows := p.windows[:0]
+	for _, window := range p.windows {
+		if !merged[window] {
+			windows = append(windows, window)
+		}
+	}
+	p.windows = windows
+	for _, result := range p.results {
+		for i, window := range result.windows {
+			if !merged[window] {
+				continue
+			}
+			result.windows[i] = survivor
+			if result.outcome == outcomeCreated || result.outcome == outcomeSplit {
+				result.outcome = outcomeMerged
+			}
+		}
+	}
+	return survivor
+}
+
+// changes returns what to save: the stored windows to grow, the new windows,
+// and the slots of the spans not covered by stored windows yet.
+func (p *availabilityPlan) changes() ([]storage.AvailabilityMerge, []models.Availability, []models.Slot) {
+	var merges []storage.AvailabilityMerge
+	var availabilities []models.Availability
+	var slots []models.Slot
+	for _, window := range p.windows {
+		switch {
+		case window.grown:
+			merges = append(merges, storage.AvailabilityMerge{
+				AvailabilityID: window.availability.ID,
+				StartTime:      window.availability.StartTime,
+				EndTime:        window.availability.EndTime,
+				Absorbed:       window.absorbed,
+			})
+		case !window.stored:
+			availabilities = append(availabilities, window.availability)
+		default:
+			continue
+		}
+
+		// Split the parts without slots yet using the provider's settings
+		for _, gap := range window.interval().subtract(window.covered) {
+			part := window.availability
+			part.StartTime, part.EndTime = gap.start, gap.end
+			slots = append(slots, splitIntoSlots(part, p.settings)...)
+		}
+	}
+	return merges, availabilities, slots
+}
+
+// response returns the result of each time slot.
+func (p *availabilityPlan) response() []*pb.AvailabilityResult {
+	results := make([]*pb.AvailabilityResult, 0, len(p.results))
+	for i, result := range p.results {
+		pbResult := &pb.AvailabilityResult{
+			Index:   int32(i),
+			Outcome: result.outcome,
+			Reason:  result.reason,
+		}
+		seen := make(map[*plannedWindow]bool)
+		for _, window := range result.windows {
+			if seen[window] {
+				continue
+			}
+			seen[window] = true
+			pbResult.Windows = append(pbResult.Windows, &pb.TimeSlot{
+				Id:        window.availability.ID,
+				StartTime: window.availability.StartTime.Format(time.RFC3339),
+				EndTime:   window.availability.EndTime.Format(time.RFC3339),
+			})
+		}
+		results = append(results, pbResult)
+	}
+	return results
+}
+
+// describeWindow names a window in a rejection reason.
+func describeWindow(window *plannedWindow) string {
+	return fmt.Sprintf("availability from %s to %s",
+		window.availability.StartTime.Format(time.RFC3339), window.availability.EndTime.Format(time.RFC3339))
+}
+
+// unsavedResults returns the results of a request that saves nothing: the
+// invalid time slots with their errors, and the valid ones skipped.
+func unsavedResults(spans []*interval, timeSlotErrors []*pb.TimeSlotError) []*pb.AvailabilityResult {
+	invalid := make(map[int]string)
+	for _, timeSlotErr := range timeSlotErrors {
+		invalid[int(timeSlotErr.Index)] = timeSlotErr.Message
+	}
+
+	results := make([]*pb.AvailabilityResult, 0, len(spans))
+	for i, span := range spans {
+		result := &pb.AvailabilityResult{Index: int32(i)}
+		if span == nil {
+			result.Outcome = outcomeInvalid
+			result.Reason = invalid[i]
+		} else {
+			result.Outcome = outcomeSkipped
+			result.Reason = "not saved because other time slots are invalid"
+		}
+		results = append(results, result)
+	}
+	return results
+}
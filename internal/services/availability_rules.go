@@ -0,0 +1,404 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/twitchtv/twirp"
+
+	pb "github.com/manueldelreal/health-reservation-system/api"
+	"github.com/manueldelreal/health-reservation-system/internal/auth"
+	"github.com/manueldelreal/health-reservation-system/internal/metrics"
+	"github.com/manueldelreal/health-reservation-system/internal/models"
+)
+
+func (s *ReservationService) CreateAvailabilityRule(ctx context.Context, req *pb.CreateAvailabilityRuleRequest) (*pb.CreateAvailabilityRuleResponse, error) {
+	if err := authorize(ctx, auth.Provider(req.ProviderId)); err != nil {
+		return nil, err
+	}
+
+	// Validate that the provider exists
+	provider, err := s.Repo.GetProvider(ctx, req.ProviderId)
+	if err != nil {
+		return nil, lookupError(err, "provider", req.ProviderId)
+	}
+
+	// Validate the weekdays
+	if len(req.Weekdays) == 0 {
+		return nil, invalidArgument("weekdays", "at least one weekday is required")
+	}
+	var weekdays []string
+	for _, name := range req.Weekdays {
+		weekday, err := parseWeekday(name)
+		if err != nil {
+			return nil, err
+		}
+		weekdays = append(weekdays, weekday.String())
+	}
+
+	// Validate the daily start and end times
+	startClock, err := time.Parse("15:04", req.StartTime)
+	if err != nil {
+		return nil, invalidArgument("start_time", "invalid start time format")
+	}
+	endClock, err := time.Parse("15:04", req.EndTime)
+	if err != nil {
+		return nil, invalidArgument("end_time", "invalid end time format")
+	}
+	if !endClock.After(startClock) {
+		return nil, invalidArgument("end_time", "end time must be after start time")
+	}
+	if settings := s.providerSettings(provider); endClock.Sub(startClock)%settings.step() != 0 {
+		return nil, invalidArgument("end_time", stepMessage(settings))
+	}
+
+	// Validate the date range, starting today in the provider's time zone if
+	// no start date is given
+	loc, err := loadLocation(provider.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	startDate := req.StartDate
+	if startDate == "" {
+		startDate = time.Now().In(loc).Format("2006-01-02")
+	}
+	if _, err := time.Parse("2006-01-02", startDate); err != nil {
+		return nil, invalidArgument("start_date", "invalid start date format")
+	}
+	if req.EndDate != "" {
+		if _, err := time.Parse("2006-01-02", req.EndDate); err != nil {
+			return nil, invalidArgument("end_date", "invalid end date format")
+		}
+		if req.EndDate < startDate {
+			return nil, invalidArgument("end_date", "end date must not be before start date")
+		}
+	}
+
+	rule := models.AvailabilityRule{
+		ID:         generateID(),
+		ProviderID: req.ProviderId,
+		Weekdays:   strings.Join(weekdays, ","),
+		StartTime:  req.StartTime,
+		EndTime:    req.EndTime,
+		StartDate:  startDate,
+		EndDate:    req.EndDate,
+	}
+
+	// Validate the exceptions
+	for _, date := range req.Exceptions {
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return nil, invalidArgument("exceptions", "invalid exception date format")
+		}
+		rule.Exceptions = append(rule.Exceptions, models.AvailabilityRuleException{
+			ID:     generateID(),
+			RuleID: rule.ID,
+			Date:   date,
+		})
+	}
+
+	err = s.Repo.CreateAvailabilityRule(ctx, &rule)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	// Expand the new rule into slots right away
+	err = s.materializeRule(ctx, rule, time.Now())
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	return &pb.CreateAvailabilityRuleResponse{
+		Id:      rule.ID,
+		Message: "Availability rule created successfully",
+	}, nil
+}
+
+func (s *ReservationService) ListAvailabilityRules(ctx context.Context, req *pb.ListAvailabilityRulesRequest) (*pb.ListAvailabilityRulesResponse, error) {
+	if err := authorize(ctx, auth.Provider(req.ProviderId)); err != nil {
+		return nil, err
+	}
+
+	if req.ProviderId == "" {
+		return nil, invalidArgument("provider_id", "provider_id is required")
+	}
+
+	rules, err := s.Repo.GetAvailabilityRules(ctx, req.ProviderId)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	// Convert database results to protobuf response
+	var pbRules []*pb.AvailabilityRule
+	for _, rule := range rules {
+		var exceptions []string
+		for _, exception := range rule.Exceptions {
+			exceptions = append(exceptions, exception.Date)
+		}
+
+		pbRules = append(pbRules, &pb.AvailabilityRule{
+			Id:         rule.ID,
+			ProviderId: rule.ProviderID,
+			Weekdays:   strings.Split(rule.Weekdays, ","),
+			StartTime:  rule.StartTime,
+			EndTime:    rule.EndTime,
+			StartDate:  rule.StartDate,
+			EndDate:    rule.EndDate,
+			Exceptions: exceptions,
+		})
+	}
+
+	return &pb.ListAvailabilityRulesResponse{Rules: pbRules}, nil
+}
+
+func (s *ReservationService) DeleteAvailabilityRule(ctx context.Context, req *pb.DeleteAvailabilityRuleRequest) (*pb.DeleteAvailabilityRuleResponse, error) {
+	if err := authenticated(ctx); err != nil {
+		return nil, err
+	}
+
+	// Only the owning provider may delete an availability rule
+	rule, err := s.Repo.GetAvailabilityRule(ctx, req.Id)
+	if err != nil {
+		return nil, lookupError(err, "availability rule", req.Id)
+	}
+	if err := authorize(ctx, auth.Provider(rule.ProviderID)); err != nil {
+		return nil, err
+	}
+
+	err = s.Repo.DeleteAvailabilityRule(ctx, req.Id)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	return &pb.DeleteAvailabilityRuleResponse{Message: "Availability rule deleted successfully"}, nil
+}
+
+func (s *ReservationService) AddAvailabilityRuleException(ctx context.Context, req *pb.AddAvailabilityRuleExceptionRequest) (*pb.AddAvailabilityRuleExceptionResponse, error) {
+	if err := authenticated(ctx); err != nil {
+		return nil, err
+	}
+
+	// Only the owning provider may add an exception
+	rule, err := s.Repo.GetAvailabilityRule(ctx, req.RuleId)
+	if err != nil {
+		return nil, lookupError(err, "availability rule", req.RuleId)
+	}
+	if err := authorize(ctx, auth.Provider(rule.ProviderID)); err != nil {
+		return nil, err
+	}
+
+	// Validate the date, a day in the provider's time zone
+	provider, err := s.Repo.GetProvider(ctx, rule.ProviderID)
+	if err != nil {
+		return nil, lookupError(err, "provider", rule.ProviderID)
+	}
+	loc, err := loadLocation(provider.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	dayStart, err := time.ParseInLocation("2006-01-02", req.Date, loc)
+	if err != nil {
+		return nil, invalidArgument("date", "invalid date format")
+	}
+	dayEnd := nextDay(dayStart)
+	if !dayEnd.After(time.Now()) {
+		return nil, invalidArgument("date", "date must not be in the past")
+	}
+	for _, exception := range rule.Exceptions {
+		if exception.Date == req.Date {
+			return nil, twirp.NewError(twirp.AlreadyExists, "exception already exists").WithMeta("date", req.Date)
+		}
+	}
+
+	// Record the exception and remove the rule's unreserved slots that day
+	err = s.Repo.AddAvailabilityRuleException(ctx, models.AvailabilityRuleException{
+		ID:     generateID(),
+		RuleID: rule.ID,
+		Date:   req.Date,
+	}, dayStart, dayEnd)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	// Report the reservations left in the rule's windows that day
+	availabilities, err := s.Repo.GetAvailabilities(ctx, rule.ProviderID, dayStart, dayEnd)
+	if err != nil {
+		return nil, storageError(err)
+	}
+	var reservationIDs []string
+	for _, availability := range availabilities {
+		if availability.RuleID != rule.ID || availability.StartTime.Before(dayStart) {
+			continue
+		}
+		reservations, err := s.Repo.GetReservationsByAvailability(ctx, availability.ID)
+		if err != nil {
+			return nil, storageError(err)
+		}
+		for _, reservation := range reservations {
+			reservationIDs = append(reservationIDs, reservation.ID)
+		}
+	}
+
+	message := "Availability rule exception added successfully"
+	if len(reservationIDs) > 0 {
+		message = fmt.Sprintf("Availability rule exception added, %d reservations that day were kept", len(reservationIDs))
+	}
+	return &pb.AddAvailabilityRuleExceptionResponse{
+		Message:        message,
+		ReservationIds: reservationIDs,
+	}, nil
+}
+
+// MaterializeAvailabilityRules expands every availability rule into availability
+// windows and slots up to the materialization horizon. Windows that already
+// exist are skipped, so it is safe to run repeatedly, and windows that overlap
+// other availability are left out. Rules of inactive providers are not
+// expanded. A rule that fails is logged and the others are still expanded; the
+// returned error counts the failures.
+func (s *ReservationService) MaterializeAvailabilityRules(ctx context.Context) error {
+	rules, err := s.Repo.GetAvailabilityRules(ctx, "")
+	if err != nil {
+		return err
+	}
+	providers, err := s.Repo.ListProviders(ctx, false)
+	if err != nil {
+		return err
+	}
+	active := make(map[string]bool)
+	for _, provider := range providers {
+		active[provider.ID] = true
+	}
+
+	now := time.Now()
+	failed := 0
+	for _, rule := range rules {
+		if !active[rule.ProviderID] {
+			continue
+		}
+		if err := s.materializeRule(ctx, rule, now); err != nil {
+			slog.ErrorContext(ctx, "Failed to materialize availability rule", "rule_id", rule.ID, "provider_id", rule.ProviderID, "error", err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d availability rules failed to materialize", failed, len(rules))
+	}
+	return nil
+}
+
+// materializeRule creates the availability windows and slots of a single rule
+// from now up to the materialization horizon.
+func (s *ReservationService) materializeRule(ctx context.Context, rule models.AvailabilityRule, now time.Time) error {
+	weekdays := make(map[time.Weekday]bool)
+	for _, name := range strings.Split(rule.Weekdays, ",") {
+		weekday, err := parseWeekday(name)
+		if err != nil {
+			return err
+		}
+		weekdays[weekday] = true
+	}
+
+	exceptions := make(map[string]bool)
+	for _, exception := range rule.Exceptions {
+		exceptions[exception.Date] = true
+	}
+
+	// Fetch the provider's slot settings
+	provider, err := s.Repo.GetProvider(ctx, rule.ProviderID)
+	if err != nil {
+		return err
+	}
+	settings := s.providerSettings(provider)
+
+	// Rules are expressed in the provider's local time
+	loc, err := loadLocation(provider.TimeZone)
+	if err != nil {
+		return err
+	}
+
+	startClock, err := time.Parse("15:04", rule.StartTime)
+	if err != nil {
+		return errors.New("invalid start time format")
+	}
+	endClock, err := time.Parse("15:04", rule.EndTime)
+	if err != nil {
+		return errors.New("invalid end time format")
+	}
+
+	// The provider's slot settings may have changed since the rule was created
+	if endClock.Sub(startClock)%settings.step() != 0 {
+		return errors.New(stepMessage(settings))
+	}
+
+	var spans []interval
+	local := now.In(loc)
+	today := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	horizon := now.Add(s.MaterializationHorizon)
+	for day := today; day.Before(horizon); day = day.AddDate(0, 0, 1) {
+		date := day.Format("2006-01-02")
+		if date < rule.StartDate || (rule.EndDate != "" && date > rule.EndDate) {
+			continue
+		}
+		if !weekdays[day.Weekday()] || exceptions[date] {
+			continue
+		}
+
+		// Build the window on the local calendar so DST changes keep the
+		// wall-clock hours, then store it in UTC
+		startTime := time.Date(day.Year(), day.Month(), day.Day(), startClock.Hour(), startClock.Minute(), 0, 0, loc).UTC()
+		endTime := time.Date(day.Year(), day.Month(), day.Day(), endClock.Hour(), endClock.Minute(), 0, 0, loc).UTC()
+		if startTime.Before(now) {
+			continue
+		}
+		spans = append(spans, interval{startTime, endTime})
+	}
+	if len(spans) == 0 {
+		return nil
+	}
+
+	// Check the windows against the stored ones the way SetAvailability does in
+	// reject mode. Windows that already exist are skipped, and windows that
+	// overlap other availability are left out
+	stored, err := s.Repo.GetAvailabilities(ctx, rule.ProviderID, spans[0].start, spans[len(spans)-1].end)
+	if err != nil {
+		return err
+	}
+	plan := newAvailabilityPlan(rule.ProviderID, overlapReject, settings, stored)
+	for _, span := range spans {
+		plan.add(span)
+	}
+	for _, result := range plan.results {
+		if result.outcome == outcomeRejected {
+			slog.InfoContext(ctx, "Skipped availability rule window", "rule_id", rule.ID, "provider_id", rule.ProviderID, "reason", result.reason)
+		}
+	}
+
+	_, availabilities, slots := plan.changes()
+	if len(availabilities) == 0 {
+		return nil
+	}
+	for i := range availabilities {
+		availabilities[i].RuleID = rule.ID
+	}
+	err = s.Repo.AddAvailabilityAndSlots(ctx, rule.ProviderID, nil, availabilities, slots)
+	if err != nil {
+		return err
+	}
+	s.Metrics.SlotsCreated(metrics.SourceAvailabilityRule, len(slots))
+	return nil
+}
+
+// parseWeekday parses a full or three-letter weekday name, ignoring case.
+func parseWeekday(name string) (time.Weekday, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		full := strings.ToLower(d.String())
+		if name == full || name == full[:3] {
+			return d, nil
+		}
+	}
+	return time.Sunday, invalidArgument("weekdays", "invalid weekday: "+name)
+}
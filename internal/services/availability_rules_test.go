@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/twitchtv/twirp"
+
+	pb "github.com/manueldelreal/health-reservation-system/api"
+)
+
+// createRule creates a rule for a provider from 12:00 to 14:00 UTC on the
+// weekday of the test day, and returns its ID.
+func (b *testBooking) createRule(t *testing.T, providerID string, exceptions ...string) string {
+	t.Helper()
+	resp, err := b.service.CreateAvailabilityRule(asProvider(providerID), &pb.CreateAvailabilityRuleRequest{
+		ProviderId: providerID,
+		Weekdays:   []string{b.weekday(t)},
+		StartTime:  "12:00",
+		EndTime:    "14:00",
+		Exceptions: exceptions,
+	})
+	if err != nil {
+		t.Fatalf("CreateAvailabilityRule: %v", err)
+	}
+	return resp.Id
+}
+
+// weekday returns the name of the test day's weekday.
+func (b *testBooking) weekday(t *testing.T) string {
+	t.Helper()
+	day, err := time.Parse(time.DateOnly, b.day)
+	if err != nil {
+		t.Fatalf("invalid test day %q: %v", b.day, err)
+	}
+	return day.Weekday().String()
+}
+
+// slotsOn returns the IDs of a provider's available slots on a day, which may
+// be none.
+func (b *testBooking) slotsOn(t *testing.T, providerID, date string) []string {
+	t.Helper()
+	resp, err := b.service.GetAvailableSlots(asAdmin(), &pb.GetAvailableSlotsRequest{ProviderId: providerID, Date: date})
+	if err != nil {
+		t.Fatalf("GetAvailableSlots %s on %s: %v", providerID, date, err)
+	}
+	var ids []string
+	for _, slot := range resp.Slots {
+		ids = append(ids, slot.Id)
+	}
+	return ids
+}
+
+// weekLater returns the day a week after the test day.
+func (b *testBooking) weekLater(t *testing.T) string {
+	t.Helper()
+	day, err := time.Parse(time.DateOnly, b.day)
+	if err != nil {
+		t.Fatalf("invalid test day %q: %v", b.day, err)
+	}
+	return day.AddDate(0, 0, 7).Format(time.DateOnly)
+}
+
+func TestCreateAvailabilityRuleMaterializesSlots(t *testing.T) {
+	b := newTestBooking(t)
+	b.service.MaterializationHorizon = 14 * 24 * time.Hour
+	b.createRule(t, "p1", b.weekLater(t))
+
+	// Four 30-minute slots from the rule join the four set for the day
+	if got := len(b.slotsOn(t, "p1", b.day)); got != 8 {
+		t.Fatalf("got %d slots on the test day, want 8", got)
+	}
+	if got := b.slotsOn(t, "p1", b.weekLater(t)); len(got) != 0 {
+		t.Fatalf("got slots %v on an exception day, want none", got)
+	}
+
+	// Running the materializer again adds nothing
+	if err := b.service.MaterializeAvailabilityRules(context.Background()); err != nil {
+		t.Fatalf("MaterializeAvailabilityRules: %v", err)
+	}
+	if got := len(b.slotsOn(t, "p1", b.day)); got != 8 {
+		t.Fatalf("got %d slots on the test day after materializing again, want 8", got)
+	}
+}
+
+func TestCreateAvailabilityRuleChecksSlotStep(t *testing.T) {
+	b := newTestBooking(t)
+	_, err := b.service.CreateAvailabilityRule(asProvider("p1"), &pb.CreateAvailabilityRuleRequest{
+		ProviderId: "p1",
+		Weekdays:   []string{"Monday"},
+		StartTime:  "12:00",
+		EndTime:    "12:45",
+	})
+	wantCode(t, err, twirp.InvalidArgument)
+}
+
+func TestAddAvailabilityRuleException(t *testing.T) {
+	b := newTestBooking(t)
+	b.service.MaterializationHorizon = 14 * 24 * time.Hour
+	ruleID := b.createRule(t, "p1")
+
+	// c1 books the first slot of the rule on the test day
+	slots := b.slotsOn(t, "p1", b.day)
+	held := b.reserve(t, "c1", slots[4])
+
+	_, err := b.service.AddAvailabilityRuleException(asClient("c1"), &pb.AddAvailabilityRuleExceptionRequest{RuleId: ruleID, Date: b.day})
+	wantCode(t, err, twirp.PermissionDenied)
+
+	resp, err := b.service.AddAvailabilityRuleException(asProvider("p1"), &pb.AddAvailabilityRuleExceptionRequest{RuleId: ruleID, Date: b.day})
+	if err != nil {
+		t.Fatalf("AddAvailabilityRuleException: %v", err)
+	}
+	if len(resp.ReservationIds) != 1 || resp.ReservationIds[0] != held.ReservationId {
+		t.Fatalf("got kept reservations %v, want [%s]", resp.ReservationIds, held.ReservationId)
+	}
+
+	// Only the one-off window's slots are left that day, and the reservation
+	// and the next week are untouched
+	if got := b.slotsOn(t, "p1", b.day); len(got) != 4 {
+		t.Fatalf("got %d slots on the exception day, want the 4 one-off slots", len(got))
+	}
+	if _, err := b.repo.GetReservation(context.Background(), held.ReservationId); err != nil {
+		t.Fatalf("GetReservation of the kept reservation: %v", err)
+	}
+	if got := len(b.slotsOn(t, "p1", b.weekLater(t))); got != 4 {
+		t.Fatalf("got %d slots a week later, want 4", got)
+	}
+
+	// The materializer does not recreate the day
+	if err := b.service.MaterializeAvailabilityRules(context.Background()); err != nil {
+		t.Fatalf("MaterializeAvailabilityRules: %v", err)
+	}
+	if got := b.slotsOn(t, "p1", b.day); len(got) != 4 {
+		t.Fatalf("got %d slots on the exception day after materializing, want 4", len(got))
+	}
+
+	_, err = b.service.AddAvailabilityRuleException(asProvider("p1"), &pb.AddAvailabilityRuleExceptionRequest{RuleId: ruleID, Date: b.day})
+	wantCode(t, err, twirp.AlreadyExists)
+	_, err = b.service.AddAvailabilityRuleException(asProvider("p1"), &pb.AddAvailabilityRuleExceptionRequest{RuleId: ruleID, Date: "2020-01-01"})
+	wantCode(t, err, twirp.InvalidArgument)
+}
+
+func TestMaterializeAvailabilityRulesSkipsFailingRule(t *testing.T) {
+	b := newTestBooking(t)
+	ctx := context.Background()
+	b.service.MaterializationHorizon = 4 * 24 * time.Hour
+	b.createRule(t, "p1")
+	b.createRule(t, "p2")
+
+	// p1's 2-hour rule no longer holds a whole number of 45-minute slots
+	provider, err := b.repo.GetProvider(ctx, "p1")
+	if err != nil {
+		t.Fatalf("GetProvider: %v", err)
+	}
+	provider.SlotDurationMinutes = 45
+	if err := b.repo.UpdateProvider(ctx, provider); err != nil {
+		t.Fatalf("UpdateProvider: %v", err)
+	}
+
+	b.service.MaterializationHorizon = 14 * 24 * time.Hour
+	if err := b.service.MaterializeAvailabilityRules(ctx); err == nil {
+		t.Fatalf("MaterializeAvailabilityRules succeeded with a failing rule")
+	}
+	if got := b.slotsOn(t, "p1", b.weekLater(t)); len(got) != 0 {
+		t.Fatalf("got slots %v from the failing rule, want none", got)
+	}
+	if got := len(b.slotsOn(t, "p2", b.weekLater(t))); got != 4 {
+		t.Fatalf("got %d slots from the other rule, want 4", got)
+	}
+}
@@ -0,0 +1,169 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/twitchtv/twirp"
+
+	pb "github.com/manueldelreal/health-reservation-system/api"
+	"github.com/manueldelreal/health-reservation-system/internal/models"
+)
+
+func TestRemoveAvailabilityCancelsReservationsWithForce(t *testing.T) {
+	b := newTestBooking(t)
+	ctx := context.Background()
+
+	// c1 books every slot and cancels the first, which is offered to c2
+	var held []*pb.ReserveSlotResponse
+	for _, slotID := range b.slots(t, "p1") {
+		held = append(held, b.reserve(t, "c1", slotID))
+	}
+	if _, err := b.service.JoinWaitlist(asClient("c2"), &pb.JoinWaitlistRequest{ClientId: "c2", ProviderId: "p1", StartDate: b.day, EndDate: b.day}); err != nil {
+		t.Fatalf("JoinWaitlist: %v", err)
+	}
+	if _, err := b.service.CancelReservation(asClient("c1"), &pb.CancelReservationRequest{ReservationId: held[0].ReservationId}); err != nil {
+		t.Fatalf("CancelReservation: %v", err)
+	}
+
+	req := &pb.RemoveAvailabilityRequest{ProviderId: "p1", StartTime: b.day + "T09:00:00Z", EndTime: b.day + "T11:00:00Z"}
+	_, err := b.service.RemoveAvailability(asProvider("p1"), req)
+	wantCode(t, err, twirp.FailedPrecondition)
+	if _, err := b.repo.GetReservation(ctx, held[1].ReservationId); err != nil {
+		t.Fatalf("GetReservation after a refused removal: %v", err)
+	}
+
+	req.Force = true
+	resp, err := b.service.RemoveAvailability(asProvider("p1"), req)
+	if err != nil {
+		t.Fatalf("RemoveAvailability: %v", err)
+	}
+	if len(resp.CancelledReservations) != 4 {
+		t.Fatalf("got %d cancelled reservations, want c1's 3 and c2's offer", len(resp.CancelledReservations))
+	}
+	for _, cancelled := range resp.CancelledReservations {
+		if _, err := b.repo.GetReservation(ctx, cancelled.ReservationId); err == nil {
+			t.Fatalf("reservation %s is still booked after the removal", cancelled.ReservationId)
+		}
+	}
+	if got := b.slotsOn(t, "p1", b.day); len(got) != 0 {
+		t.Fatalf("got slots %v after the removal, want none", got)
+	}
+
+	// The offer of the cancelled hold was declined with it
+	waitlist, err := b.service.GetWaitlistByClient(asClient("c2"), &pb.GetWaitlistByClientRequest{ClientId: "c2"})
+	if err != nil {
+		t.Fatalf("GetWaitlistByClient: %v", err)
+	}
+	if len(waitlist.Entries) != 1 || waitlist.Entries[0].Status != models.WaitlistDeclined {
+		t.Fatalf("got waitlist entries %v, want one declined", waitlist.Entries)
+	}
+}
+
+func TestUpdateAvailabilityCancelsReservationsOutsideWithForce(t *testing.T) {
+	b := newTestBooking(t)
+	slots := b.slots(t, "p1")
+	kept := b.reserve(t, "c1", slots[0])
+	outside := b.reserve(t, "c2", slots[3])
+
+	// Shrinking the window to 09:00-10:00 cancels the 10:30 reservation only
+	resp, err := b.service.UpdateAvailability(asProvider("p1"), &pb.UpdateAvailabilityRequest{
+		ProviderId:   "p1",
+		StartTime:    b.day + "T09:00:00Z",
+		EndTime:      b.day + "T11:00:00Z",
+		NewStartTime: b.day + "T09:00:00Z",
+		NewEndTime:   b.day + "T10:00:00Z",
+		Force:        true,
+		Reason:       "Leaving early",
+	})
+	if err != nil {
+		t.Fatalf("UpdateAvailability: %v", err)
+	}
+	if len(resp.CancelledReservations) != 1 || resp.CancelledReservations[0].ReservationId != outside.ReservationId {
+		t.Fatalf("got cancelled reservations %v, want only %s", resp.CancelledReservations, outside.ReservationId)
+	}
+	if _, err := b.repo.GetReservation(context.Background(), kept.ReservationId); err != nil {
+		t.Fatalf("GetReservation of the reservation inside the window: %v", err)
+	}
+	if got := b.slots(t, "p1"); len(got) != 1 || got[0] != slots[1] {
+		t.Fatalf("got slots %v after shrinking, want only %s", got, slots[1])
+	}
+}
+
+// setAvailability adds time slots to a provider's availability on the test day,
+// given as "HH:MM-HH:MM" in UTC, and returns the result of each.
+func (b *testBooking) setAvailability(t *testing.T, providerID, mode string, spans ...string) []*pb.AvailabilityResult {
+	t.Helper()
+	req := &pb.SetAvailabilityRequest{ProviderId: providerID, OverlapMode: mode}
+	for _, span := range spans {
+		start, end, _ := strings.Cut(span, "-")
+		req.TimeSlots = append(req.TimeSlots, &pb.TimeSlot{
+			StartTime: b.day + "T" + start + ":00Z",
+			EndTime:   b.day + "T" + end + ":00Z",
+		})
+	}
+	resp, err := b.service.SetAvailability(asProvider(providerID), req)
+	if err != nil {
+		t.Fatalf("SetAvailability: %v", err)
+	}
+	return resp.Results
+}
+
+func TestSetAvailabilityOverlapModes(t *testing.T) {
+	b := newTestBooking(t)
+	held := b.reserve(t, "c1", b.slots(t, "p1")[3])
+
+	// Reject mode adds the window that overlaps nothing only
+	results := b.setAvailability(t, "p1", "", "10:00-12:00", "13:00-14:00")
+	if results[0].Outcome != outcomeRejected || results[1].Outcome != outcomeCreated {
+		t.Fatalf("got outcomes %s and %s, want rejected and created", results[0].Outcome, results[1].Outcome)
+	}
+
+	// Merge mode grows the 09:00-11:00 window to 12:00 and keeps its slots and
+	// the reservation
+	results = b.setAvailability(t, "p1", overlapMerge, "10:00-12:00")
+	if results[0].Outcome != outcomeMerged || len(results[0].Windows) != 1 || results[0].Windows[0].EndTime != b.day+"T12:00:00Z" {
+		t.Fatalf("got result %v, want the window merged up to 12:00", results[0])
+	}
+	if got := len(b.slots(t, "p1")); got != 3+2+2 {
+		t.Fatalf("got %d slots after merging, want 7", got)
+	}
+	if _, err := b.repo.GetReservation(context.Background(), held.ReservationId); err != nil {
+		t.Fatalf("GetReservation after merging: %v", err)
+	}
+
+	// Split mode only adds the parts of 08:00-13:00 not covered yet
+	results = b.setAvailability(t, "p1", overlapSplit, "08:00-13:00")
+	if results[0].Outcome != outcomeSplit || len(results[0].Windows) != 2 {
+		t.Fatalf("got result %v, want two split windows", results[0])
+	}
+	if got := len(b.slots(t, "p1")); got != 7+2+2 {
+		t.Fatalf("got %d slots after splitting, want 11", got)
+	}
+}
+
+func TestSetAvailabilityRejectsUnevenOverlap(t *testing.T) {
+	b := newTestBooking(t)
+
+	// 10:15-11:15 holds two 30-minute slots, but only the 15 minutes after
+	// 11:00 are new, which hold none
+	for _, mode := range []string{overlapMerge, overlapSplit} {
+		results := b.setAvailability(t, "p1", mode, "10:15-11:15")
+		if results[0].Outcome != outcomeRejected || !strings.Contains(results[0].Reason, "not a multiple") {
+			t.Fatalf("%s: got outcome %s (%s), want rejected for the uneven part", mode, results[0].Outcome, results[0].Reason)
+		}
+	}
+	if got := len(b.slots(t, "p1")); got != 4 {
+		t.Fatalf("got %d slots after the rejected time slots, want 4", got)
+	}
+
+	// A new part of whole slots is accepted
+	results := b.setAvailability(t, "p1", overlapMerge, "10:30-11:30")
+	if results[0].Outcome != outcomeMerged {
+		t.Fatalf("got outcome %s (%s), want merged", results[0].Outcome, results[0].Reason)
+	}
+	if got := len(b.slots(t, "p1")); got != 5 {
+		t.Fatalf("got %d slots after merging, want 5", got)
+	}
+}
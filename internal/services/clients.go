@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/mail"
+	"time"
+
+	"github.com/twitchtv/twirp"
+
+	pb "github.com/manueldelreal/health-reservation-system/api"
+	"github.com/manueldelreal/health-reservation-system/internal/auth"
+	"github.com/manueldelreal/health-reservation-system/internal/models"
+	"github.com/manueldelreal/health-reservation-system/internal/storage"
+)
+
+func (s *ReservationService) CreateClient(ctx context.Context, req *pb.CreateClientRequest) (*pb.CreateClientResponse, error) {
+	if err := authorize(ctx, auth.Client(req.Id)); err != nil {
+		return nil, err
+	}
+
+	if req.Id == "" {
+		return nil, invalidArgument("id", "id is required")
+	}
+
+	// Check if the client already exists
+	_, err := s.Repo.GetClient(ctx, req.Id)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return nil, storageError(err)
+	}
+	if err == nil {
+		return nil, twirp.NewError(twirp.AlreadyExists, "client already exists").WithMeta("client_id", req.Id)
+	}
+
+	client := models.Client{
+		ID:          req.Id,
+		Name:        req.Name,
+		Email:       req.Email,
+		Phone:       req.Phone,
+		DateOfBirth: req.DateOfBirth,
+		ExternalID:  req.ExternalId,
+	}
+	if err := validateClient(client); err != nil {
+		return nil, err
+	}
+
+	err = s.Repo.CreateClient(ctx, client)
+	if err != nil {
+		return nil, clientStorageError(err, client)
+	}
+
+	return &pb.CreateClientResponse{
+		Message: "Client created successfully",
+	}, nil
+}
+
+func (s *ReservationService) GetClient(ctx context.Context, req *pb.GetClientRequest) (*pb.GetClientResponse, error) {
+	if err := authorize(ctx, auth.Client(req.Id)); err != nil {
+		return nil, err
+	}
+
+	client, err := s.Repo.GetClient(ctx, req.Id)
+	if err != nil {
+		return nil, lookupError(err, "client", req.Id)
+	}
+
+	return &pb.GetClientResponse{
+		Id:          client.ID,
+		Name:        client.Name,
+		Email:       client.Email,
+		Phone:       client.Phone,
+		DateOfBirth: client.DateOfBirth,
+		ExternalId:  client.ExternalID,
+	}, nil
+}
+
+func (s *ReservationService) UpdateClient(ctx context.Context, req *pb.UpdateClientRequest) (*pb.UpdateClientResponse, error) {
+	if err := authorize(ctx, auth.Client(req.Id)); err != nil {
+		return nil, err
+	}
+
+	// Validate that the client exists
+	client, err := s.Repo.GetClient(ctx, req.Id)
+	if err != nil {
+		return nil, lookupError(err, "client", req.Id)
+	}
+
+	// Replace the client's name, contact details and identifiers
+	client.Name = req.Name
+	client.Email = req.Email
+	client.Phone = req.Phone
+	client.DateOfBirth = req.DateOfBirth
+	client.ExternalID = req.ExternalId
+	if err := validateClient(client); err != nil {
+		return nil, err
+	}
+
+	err = s.Repo.UpdateClient(ctx, client)
+	if err != nil {
+		return nil, clientStorageError(err, client)
+	}
+
+	return &pb.UpdateClientResponse{
+		Message: "Client updated successfully",
+	}, nil
+}
+
+// validateClient checks a client's name, email and date of birth.
+func validateClient(client models.Client) error {
+	if client.Name == "" {
+		return invalidArgument("name", "name is required")
+	}
+	if client.Email != "" {
+		if _, err := mail.ParseAddress(client.Email); err != nil {
+			return invalidArgument("email", "invalid email address")
+		}
+	}
+	if client.DateOfBirth != "" {
+		dateOfBirth, err := time.Parse("2006-01-02", client.DateOfBirth)
+		if err != nil {
+			return invalidArgument("date_of_birth", "invalid date of birth format")
+		}
+		if dateOfBirth.After(time.Now()) {
+			return invalidArgument("date_of_birth", "date of birth must not be in the future")
+		}
+	}
+	return nil
+}
+
+// clientStorageError converts an error saving a client. The ID was checked
+// beforehand, so a duplicate is another client with the same external ID.
+func clientStorageError(err error, client models.Client) error {
+	if errors.Is(err, storage.ErrAlreadyExists) {
+		return twirp.NewError(twirp.AlreadyExists, "another client has this external_id").WithMeta("external_id", client.ExternalID)
+	}
+	return storageError(err)
+}
@@ -0,0 +1,121 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/twitchtv/twirp"
+
+	"github.com/manueldelreal/health-reservation-system/internal/auth"
+	"github.com/manueldelreal/health-reservation-system/internal/models"
+	"github.com/manueldelreal/health-reservation-system/internal/storage"
+)
+
+// invalidArgument reports a request field that is missing or malformed. The
+// field is returned in the "argument" metadata.
+func invalidArgument(argument, msg string) twirp.Error {
+	return twirp.NewError(twirp.InvalidArgument, msg).WithMeta("argument", argument)
+}
+
+// failedPrecondition reports a request that is well formed but not allowed in
+// the current state, such as booking too late.
+func failedPrecondition(msg string) twirp.Error {
+	return twirp.NewError(twirp.FailedPrecondition, msg)
+}
+
+// storageError converts an error returned by the repository into the Twirp
+// error the client sees. Errors that are already Twirp errors are kept, and
+// anything that is not a known storage error is internal.
+func storageError(err error) error {
+	var twerr twirp.Error
+	if errors.As(err, &twerr) {
+		return twerr
+	}
+
+	var notFound *storage.NotFoundError
+	var slotErr *storage.SlotError
+	switch {
+	case errors.As(err, &notFound):
+		return twirp.NotFoundError(notFound.Error()).WithMeta(idMetaKey(notFound.Kind), notFound.ID)
+	case errors.Is(err, storage.ErrNotFound):
+		return twirp.NotFoundError(err.Error())
+	case errors.As(err, &slotErr):
+		return failedPrecondition(slotErr.Error()).WithMeta("slot_id", slotErr.SlotID)
+	case errors.Is(err, storage.ErrAlreadyExists):
+		return twirp.NewError(twirp.AlreadyExists, err.Error())
+	case errors.Is(err, storage.ErrAlreadyConfirmed), errors.Is(err, storage.ErrAvailabilityReserved),
+		errors.Is(err, storage.ErrHoldExpired), errors.Is(err, storage.ErrHoldExtensionLimit),
+		errors.Is(err, storage.ErrNoOffer):
+		return failedPrecondition(err.Error())
+	default:
+		return twirp.InternalErrorWith(err)
+	}
+}
+
+// lookupError converts an error fetching a record the request names. A missing
+// record is NotFound with its ID in the metadata.
+func lookupError(err error, kind, id string) error {
+	if errors.Is(err, storage.ErrNotFound) {
+		return storageError(&storage.NotFoundError{Kind: kind, ID: id})
+	}
+	return storageError(err)
+}
+
+// slotLookupError converts an error fetching the Available slot a request
+// names. A missing slot may be held by someone else, so it is a failed
+// precondition rather than NotFound.
+func slotLookupError(err error, slotID string) error {
+	if errors.Is(err, storage.ErrNotFound) {
+		return storageError(&storage.SlotError{SlotID: slotID, Err: storage.ErrSlotUnavailable})
+	}
+	return storageError(err)
+}
+
+// idMetaKey returns the metadata key of a record ID, such as
+// "appointment_type_id" for an "appointment type".
+func idMetaKey(kind string) string {
+	return strings.ReplaceAll(kind, " ", "_") + "_id"
+}
+
+// authorize fails unless the caller is an admin or one of the allowed
+// principals. With no allowed principals only admins pass.
+func authorize(ctx context.Context, allowed ...auth.Principal) error {
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return twirp.NewError(twirp.Unauthenticated, "authentication required")
+	}
+	if principal.Role == auth.RoleAdmin {
+		return nil
+	}
+	for _, p := range allowed {
+		if principal == p {
+			return nil
+		}
+	}
+	return twirp.NewError(twirp.PermissionDenied, "permission denied")
+}
+
+// authenticated fails unless the request carries a principal of any role.
+func authenticated(ctx context.Context) error {
+	if _, ok := auth.FromContext(ctx); !ok {
+		return twirp.NewError(twirp.Unauthenticated, "authentication required")
+	}
+	return nil
+}
+
+// authorizeReservation fails unless the caller is an admin, the client who
+// booked the reservation or its provider.
+func authorizeReservation(ctx context.Context, reservation models.Reservation) error {
+	return authorize(ctx, auth.Client(reservation.ClientID), auth.Provider(reservation.ProviderID))
+}
+
+// callerName returns who the caller is, for audit records: the provider or
+// client ID of the principal, or the role of an admin.
+func callerName(ctx context.Context) string {
+	principal, _ := auth.FromContext(ctx)
+	if principal.Subject != "" {
+		return principal.Subject
+	}
+	return string(principal.Role)
+}
@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/twitchtv/twirp"
+
+	pb "github.com/manueldelreal/health-reservation-system/api"
+	"github.com/manueldelreal/health-reservation-system/internal/models"
+	"github.com/manueldelreal/health-reservation-system/internal/storage"
+)
+
+// holdTokenBytes is the number of random bytes in a hold token.
+const holdTokenBytes = 32
+
+func (s *ReservationService) ExtendHold(ctx context.Context, req *pb.ExtendHoldRequest) (*pb.ExtendHoldResponse, error) {
+	if err := authenticated(ctx); err != nil {
+		return nil, err
+	}
+
+	// Fetch the reservation to check who may extend it
+	reservation, err := s.Repo.GetReservation(ctx, req.ReservationId)
+	if err != nil {
+		return nil, lookupError(err, "reservation", req.ReservationId)
+	}
+	if err := authorizeReservation(ctx, reservation); err != nil {
+		return nil, err
+	}
+	if err := checkHoldToken(reservation, req.HoldToken); err != nil {
+		return nil, err
+	}
+
+	// Push the expiry back by another hold period, unless the hold has expired
+	// or used up its extensions
+	reservation, err = s.Repo.ExtendHold(ctx, reservation.ID, s.HoldExpiry, s.MaxHoldExtensions)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	return &pb.ExtendHoldResponse{
+		Message:             "Hold extended",
+		HoldExpiresAt:       reservation.ReservationExpiry.UTC().Format(time.RFC3339),
+		ExtensionsRemaining: int32(s.MaxHoldExtensions - reservation.HoldExtensions),
+	}, nil
+}
+
+// withoutHoldToken removes the hold token from a ReserveSlot response before it
+// is stored for retries.
+func withoutHoldToken(resp *pb.ReserveSlotResponse) *pb.ReserveSlotResponse {
+	resp.HoldToken = ""
+	return resp
+}
+
+// reissueHoldToken gives a replayed ReserveSlot response a new hold token,
+// which replaces the one issued by the first call. A hold that has been
+// confirmed, has expired or is gone needs no token, so its response is
+// returned as stored, without one.
+func (s *ReservationService) reissueHoldToken(ctx context.Context, resp *pb.ReserveSlotResponse) (*pb.ReserveSlotResponse, error) {
+	holdToken, err := newHoldToken()
+	if err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+	reservation, err := s.Repo.ReplaceHoldToken(ctx, resp.ReservationId, hashHoldToken(holdToken))
+	if errors.Is(err, storage.ErrAlreadyConfirmed) || errors.Is(err, storage.ErrHoldExpired) || errors.Is(err, storage.ErrNotFound) {
+		return resp, nil
+	}
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	resp.HoldToken = holdToken
+	if reservation.ReservationExpiry != nil {
+		resp.HoldExpiresAt = reservation.ReservationExpiry.UTC().Format(time.RFC3339)
+	}
+	return resp, nil
+}
+
+// newHoldToken returns a random token that confirms or extends a hold.
+func newHoldToken() (string, error) {
+	token := make([]byte, holdTokenBytes)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// hashHoldToken returns the hash a hold token is stored as.
+func hashHoldToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// checkHoldToken fails unless token is the hold token of a reservation.
+func checkHoldToken(reservation models.Reservation, token string) error {
+	if token == "" {
+		return invalidArgument("hold_token", "hold token is required")
+	}
+	if reservation.HoldTokenHash == "" || subtle.ConstantTimeCompare([]byte(hashHoldToken(token)), []byte(reservation.HoldTokenHash)) != 1 {
+		return twirp.NewError(twirp.PermissionDenied, "hold token does not match the reservation")
+	}
+	return nil
+}
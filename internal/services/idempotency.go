@@ -0,0 +1,153 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/manueldelreal/health-reservation-system/internal/auth"
+	"github.com/manueldelreal/health-reservation-system/internal/idempotency"
+	"github.com/manueldelreal/health-reservation-system/internal/models"
+	"github.com/manueldelreal/health-reservation-system/internal/storage"
+)
+
+// DefaultIdempotencyTTL is how long responses are replayed for retries when
+// the service does not set its own.
+const DefaultIdempotencyTTL = 24 * time.Hour
+
+// maxIdempotencyKeyLength is the longest idempotency key accepted.
+const maxIdempotencyKeyLength = 255
+
+// idempotencyLease is how long a key stays claimed by a request that has not
+// finished, so that a request lost with its server does not block the key
+// until its TTL ends.
+const idempotencyLease = time.Minute
+
+// idempotencyKeyField is the request field an idempotency key can be given in,
+// instead of the Idempotency-Key header.
+const idempotencyKeyField = "idempotency_key"
+
+// idempotent runs handle once for each idempotency key. The key is the
+// request's idempotency_key field, or else its Idempotency-Key header, and is
+// scoped to the method and the caller. A retry with the same key and request
+// gets the stored response of the first call, and one with a different request
+// is rejected. Failed calls are not stored, so they can be retried. Requests
+// without a key always run. When redact is set, the response is stored as
+// redact returns it, so that secrets are not kept; the caller of the first call
+// still gets them.
+func idempotent[Resp proto.Message](ctx context.Context, s *ReservationService, method string, req proto.Message, handle func() (Resp, error), redact func(Resp) Resp) (Resp, error) {
+	var zero Resp
+
+	// The field takes precedence over the header
+	field := req.ProtoReflect().Descriptor().Fields().ByName(idempotencyKeyField)
+	key := req.ProtoReflect().Get(field).String()
+	if key == "" {
+		key = idempotency.FromContext(ctx)
+	}
+	if key == "" {
+		return handle()
+	}
+	if len(key) > maxIdempotencyKeyLength {
+		return zero, invalidArgument(idempotencyKeyField, "idempotency key must be at most 255 characters")
+	}
+
+	// Hash the request without its key, so that the same request sent with
+	// the key in the field or in the header matches
+	unkeyed := proto.Clone(req)
+	unkeyed.ProtoReflect().Clear(field)
+	encoded, err := proto.MarshalOptions{Deterministic: true}.Marshal(unkeyed)
+	if err != nil {
+		return zero, twirp.InternalErrorWith(err)
+	}
+	hash := sha256.Sum256(encoded)
+	record := models.IdempotencyKey{
+		ID:          idempotencyKeyID(ctx, method, key),
+		RequestHash: hex.EncodeToString(hash[:]),
+		ExpiresAt:   time.Now().UTC().Add(idempotencyLease),
+	}
+
+	// Claim the key, or replay the response of the request that claimed it
+	err = s.Repo.CreateIdempotencyKey(ctx, record)
+	if errors.Is(err, storage.ErrAlreadyExists) {
+		return replayResponse[Resp](ctx, s, record)
+	}
+	if err != nil {
+		return zero, storageError(err)
+	}
+
+	resp, err := handle()
+	if err != nil {
+		// Release the key so that the request can be retried
+		if deleteErr := s.Repo.DeleteIdempotencyKey(context.WithoutCancel(ctx), record.ID); deleteErr != nil {
+			slog.WarnContext(ctx, "Failed to release idempotency key", "method", method, "error", deleteErr)
+		}
+		return zero, err
+	}
+
+	// Keep the response for retries. The call has succeeded, so a response
+	// that cannot be kept only releases the key
+	stored := resp
+	if redact != nil {
+		stored = redact(proto.Clone(resp).(Resp))
+	}
+	encoded, err = proto.Marshal(stored)
+	if err == nil {
+		err = s.Repo.SaveIdempotentResponse(context.WithoutCancel(ctx), record.ID, encoded, time.Now().UTC().Add(s.idempotencyTTL()))
+	}
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to save idempotent response", "method", method, "error", err)
+		if deleteErr := s.Repo.DeleteIdempotencyKey(context.WithoutCancel(ctx), record.ID); deleteErr != nil {
+			slog.WarnContext(ctx, "Failed to release idempotency key", "method", method, "error", deleteErr)
+		}
+	}
+	return resp, nil
+}
+
+// replayResponse returns the stored response of the request that claimed an
+// idempotency key before.
+func replayResponse[Resp proto.Message](ctx context.Context, s *ReservationService, record models.IdempotencyKey) (Resp, error) {
+	var zero Resp
+	stored, err := s.Repo.GetIdempotencyKey(ctx, record.ID)
+	if errors.Is(err, storage.ErrNotFound) {
+		// The first request failed and released the key in the meantime
+		return zero, twirp.NewError(twirp.Aborted, "a request with this idempotency key has just finished, retry it")
+	}
+	if err != nil {
+		return zero, storageError(err)
+	}
+
+	if stored.RequestHash != record.RequestHash {
+		return zero, invalidArgument(idempotencyKeyField, "idempotency key was already used for a different request")
+	}
+	if len(stored.Response) == 0 {
+		return zero, twirp.NewError(twirp.Aborted, "a request with this idempotency key is still in progress")
+	}
+
+	resp := zero.ProtoReflect().New().Interface().(Resp)
+	if err := proto.Unmarshal(stored.Response, resp); err != nil {
+		return zero, twirp.InternalErrorWith(err)
+	}
+	_ = twirp.SetHTTPResponseHeader(ctx, idempotency.ReplayedHeader, "true")
+	return resp, nil
+}
+
+// idempotencyKeyID scopes an idempotency key to a method and the caller, so
+// that callers cannot replay each other's responses.
+func idempotencyKeyID(ctx context.Context, method, key string) string {
+	principal, _ := auth.FromContext(ctx)
+	return method + ":" + string(principal.Role) + ":" + principal.Subject + ":" + key
+}
+
+// idempotencyTTL returns how long responses are replayed for retries.
+func (s *ReservationService) idempotencyTTL() time.Duration {
+	if s.IdempotencyTTL > 0 {
+		return s.IdempotencyTTL
+	}
+	return DefaultIdempotencyTTL
+}
@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/twitchtv/twirp"
+
+	pb "github.com/manueldelreal/health-reservation-system/api"
+	"github.com/manueldelreal/health-reservation-system/internal/idempotency"
+)
+
+func TestSetAvailabilityReplaysRetries(t *testing.T) {
+	b := newTestBooking(t)
+	req := &pb.SetAvailabilityRequest{
+		ProviderId:     "p1",
+		TimeSlots:      []*pb.TimeSlot{{StartTime: b.day + "T12:00:00Z", EndTime: b.day + "T13:00:00Z"}},
+		IdempotencyKey: "set-1",
+	}
+
+	first, err := b.service.SetAvailability(asProvider("p1"), req)
+	if err != nil {
+		t.Fatalf("SetAvailability: %v", err)
+	}
+
+	// The retry gets the first response instead of an unchanged result, and
+	// the same key in the header matches the field
+	retry, err := b.service.SetAvailability(idempotency.NewContext(asProvider("p1"), "set-1"), &pb.SetAvailabilityRequest{
+		ProviderId: req.ProviderId,
+		TimeSlots:  req.TimeSlots,
+	})
+	if err != nil {
+		t.Fatalf("SetAvailability retry: %v", err)
+	}
+	if retry.Results[0].Outcome != outcomeCreated || retry.Results[0].Windows[0].Id != first.Results[0].Windows[0].Id {
+		t.Fatalf("got retry result %v, want the first %v", retry.Results[0], first.Results[0])
+	}
+	if got := len(b.slots(t, "p1")); got != 6 {
+		t.Fatalf("got %d slots after the retry, want 6", got)
+	}
+
+	// The key cannot be reused for another request
+	other := &pb.SetAvailabilityRequest{
+		ProviderId:     "p1",
+		TimeSlots:      []*pb.TimeSlot{{StartTime: b.day + "T14:00:00Z", EndTime: b.day + "T15:00:00Z"}},
+		IdempotencyKey: "set-1",
+	}
+	_, err = b.service.SetAvailability(asProvider("p1"), other)
+	wantCode(t, err, twirp.InvalidArgument)
+
+	other.IdempotencyKey = strings.Repeat("k", maxIdempotencyKeyLength+1)
+	_, err = b.service.SetAvailability(asProvider("p1"), other)
+	wantCode(t, err, twirp.InvalidArgument)
+}
+
+func TestReserveSlotReplaysRetries(t *testing.T) {
+	b := newTestBooking(t)
+	slots := b.slots(t, "p1")
+	req := &pb.ReserveSlotRequest{SlotId: slots[0], ClientId: "c1", IdempotencyKey: "reserve-1"}
+
+	first, err := b.service.ReserveSlot(asClient("c1"), req)
+	if err != nil {
+		t.Fatalf("ReserveSlot: %v", err)
+	}
+	retry, err := b.service.ReserveSlot(asClient("c1"), req)
+	if err != nil {
+		t.Fatalf("ReserveSlot retry: %v", err)
+	}
+	if retry.ReservationId != first.ReservationId {
+		t.Fatalf("got reservation %s on retry, want %s", retry.ReservationId, first.ReservationId)
+	}
+	if got := len(b.slots(t, "p1")); got != len(slots)-1 {
+		t.Fatalf("got %d slots after the retry, want %d", got, len(slots)-1)
+	}
+
+	// Keys are scoped to the caller, so c2's request with the same key runs
+	theirs, err := b.service.ReserveSlot(asClient("c2"), &pb.ReserveSlotRequest{SlotId: slots[1], ClientId: "c2", IdempotencyKey: "reserve-1"})
+	if err != nil {
+		t.Fatalf("ReserveSlot by c2: %v", err)
+	}
+	if theirs.ReservationId == first.ReservationId {
+		t.Fatalf("c2 got c1's reservation %s", first.ReservationId)
+	}
+
+	// A failed call releases its key for the next attempt
+	_, err = b.service.ReserveSlot(asClient("c1"), &pb.ReserveSlotRequest{SlotId: "missing", ClientId: "c1", IdempotencyKey: "reserve-2"})
+	wantCode(t, err, twirp.FailedPrecondition)
+	if _, err := b.service.ReserveSlot(asClient("c1"), &pb.ReserveSlotRequest{SlotId: slots[2], ClientId: "c1", IdempotencyKey: "reserve-2"}); err != nil {
+		t.Fatalf("ReserveSlot after a failed call with the same key: %v", err)
+	}
+}
+
+func TestReserveSlotReplaysSettledHolds(t *testing.T) {
+	b := newTestBooking(t)
+	slots := b.slots(t, "p1")
+
+	// A retry after the hold was confirmed gets the reservation without a token
+	req := &pb.ReserveSlotRequest{SlotId: slots[0], ClientId: "c1", IdempotencyKey: "confirmed"}
+	held, err := b.service.ReserveSlot(asClient("c1"), req)
+	if err != nil {
+		t.Fatalf("ReserveSlot: %v", err)
+	}
+	if _, err := b.service.ConfirmReservation(asClient("c1"), &pb.ConfirmReservationRequest{ReservationId: held.ReservationId, HoldToken: held.HoldToken}); err != nil {
+		t.Fatalf("ConfirmReservation: %v", err)
+	}
+	retry, err := b.service.ReserveSlot(asClient("c1"), req)
+	if err != nil {
+		t.Fatalf("ReserveSlot retry after confirming: %v", err)
+	}
+	if retry.ReservationId != held.ReservationId || retry.HoldToken != "" {
+		t.Fatalf("got reservation %s with token %q, want %s without one", retry.ReservationId, retry.HoldToken, held.ReservationId)
+	}
+
+	// So does one after the hold expired, before and after it is released
+	b.service.HoldExpiry = -time.Minute
+	req = &pb.ReserveSlotRequest{SlotId: slots[1], ClientId: "c1", IdempotencyKey: "expired"}
+	held, err = b.service.ReserveSlot(asClient("c1"), req)
+	if err != nil {
+		t.Fatalf("ReserveSlot: %v", err)
+	}
+	for _, when := range []string{"expiring", "release"} {
+		if when == "release" {
+			if err := b.service.CleanupExpiredReservations(context.Background()); err != nil {
+				t.Fatalf("CleanupExpiredReservations: %v", err)
+			}
+		}
+		retry, err = b.service.ReserveSlot(asClient("c1"), req)
+		if err != nil {
+			t.Fatalf("ReserveSlot retry after %s: %v", when, err)
+		}
+		if retry.ReservationId != held.ReservationId || retry.HoldToken != "" {
+			t.Fatalf("after %s: got reservation %s with token %q, want %s without one", when, retry.ReservationId, retry.HoldToken, held.ReservationId)
+		}
+	}
+}
@@ -4,18 +4,59 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"math/rand"
 	"time"
 
 	"github.com/oklog/ulid/v2"
-	"gorm.io/gorm"
+	"github.com/twitchtv/twirp"
 
 	pb "github.com/manueldelreal/health-reservation-system/api"
+	"github.com/manueldelreal/health-reservation-system/internal/auth"
+	"github.com/manueldelreal/health-reservation-system/internal/metrics"
 	"github.com/manueldelreal/health-reservation-system/internal/models"
 	"github.com/manueldelreal/health-reservation-system/internal/storage"
 )
 
-type ReservationService struct{}
+// DefaultSlotDuration is the slot length for providers that do not set one.
+const DefaultSlotDuration = 15 * time.Minute
+
+type ReservationService struct {
+	// Repo stores providers, availability, slots and reservations.
+	Repo storage.Repository
+
+	// Metrics records booking activity. It may be nil.
+	Metrics *metrics.Metrics
+
+	// HoldExpiry is how long a reserved slot is held before it must be
+	// confirmed.
+	HoldExpiry time.Duration
+
+	// MinLeadTime is how far ahead slots must be booked, for providers that do
+	// not set their own.
+	MinLeadTime time.Duration
+
+	// CancellationCutoff is the minimum time left before a reservation starts
+	// for it to still be cancellable.
+	CancellationCutoff time.Duration
+
+	// MaxHoldExtensions is how many times a hold can be extended by
+	// HoldExpiry.
+	MaxHoldExtensions int
+
+	// MaterializationHorizon is how far ahead availability rules are expanded
+	// into slots.
+	MaterializationHorizon time.Duration
+
+	// WaitlistOfferExpiry is how long a slot offered to a waitlisted client is
+	// held for them to accept. Zero means DefaultWaitlistOfferExpiry.
+	WaitlistOfferExpiry time.Duration
+
+	// IdempotencyTTL is how long the response to a request made with an
+	// idempotency key is replayed for retries. Zero means
+	// DefaultIdempotencyTTL.
+	IdempotencyTTL time.Duration
+}
 
 // generateID generates a new ULID as a string.
 func generateID() string {
@@ -24,100 +65,286 @@ func generateID() string {
 }
 
 func (s *ReservationService) SetAvailability(ctx context.Context, req *pb.SetAvailabilityRequest) (*pb.SetAvailabilityResponse, error) {
+	return idempotent(ctx, s, "SetAvailability", req, func() (*pb.SetAvailabilityResponse, error) {
+		return s.setAvailability(ctx, req)
+	}, nil)
+}
+
+// setAvailability adds the time slots of a SetAvailability request, once for
+// each idempotency key.
+func (s *ReservationService) setAvailability(ctx context.Context, req *pb.SetAvailabilityRequest) (*pb.SetAvailabilityResponse, error) {
+	if err := authorize(ctx, auth.Provider(req.ProviderId)); err != nil {
+		return nil, err
+	}
+
 	// Validate that the provider exists
-	var provider models.Provider
-	err := storage.DB.First(&provider, "id = ?", req.ProviderId)
+	provider, err := s.Repo.GetProvider(ctx, req.ProviderId)
 	if err != nil {
-		return nil, errors.New("provider not found")
+		return nil, lookupError(err, "provider", req.ProviderId)
 	}
 
-	availabilityMap := make(map[string]models.Availability)
-	var slots []models.Slot
+	mode := req.OverlapMode
+	switch mode {
+	case "":
+		mode = overlapReject
+	case overlapReject, overlapMerge, overlapSplit:
+	default:
+		return nil, invalidArgument("overlap_mode", "overlap mode must be reject, merge or split")
+	}
 
-	for _, timeSlot := range req.TimeSlots {
-		// Parse start and end times
-		startTime, err := time.Parse(time.RFC3339, timeSlot.StartTime)
-		if err != nil {
-			return nil, errors.New("invalid start time format")
+	// Validate every time slot, keeping the spans of the valid ones
+	settings := s.providerSettings(provider)
+	now := time.Now()
+	spans := make([]*interval, len(req.TimeSlots))
+	var timeSlotErrors []*pb.TimeSlotError
+	var valid []interval
+	for i, timeSlot := range req.TimeSlots {
+		span, timeSlotErr := validateTimeSlot(timeSlot, settings, now)
+		if timeSlotErr != nil {
+			timeSlotErr.Index = int32(i)
+			timeSlotErrors = append(timeSlotErrors, timeSlotErr)
+			continue
 		}
-		endTime, err := time.Parse(time.RFC3339, timeSlot.EndTime)
-		if err != nil {
-			return nil, errors.New("invalid end time format")
+		spans[i] = &span
+		valid = append(valid, span)
+	}
+
+	// Without partial accept, one invalid time slot saves nothing
+	if len(timeSlotErrors) > 0 && (!req.PartialAccept || len(valid) == 0) {
+		return &pb.SetAvailabilityResponse{
+			Message: fmt.Sprintf("No availability set, %d of %d time slots are invalid", len(timeSlotErrors), len(req.TimeSlots)),
+			Results: unsavedResults(spans, timeSlotErrors),
+			Errors:  timeSlotErrors,
+		}, nil
+	}
+	if len(valid) == 0 {
+		return &pb.SetAvailabilityResponse{Message: "Availability set successfully"}, nil
+	}
+
+	// Fetch the stored windows the request may overlap
+	first, last := valid[0].start, valid[0].end
+	for _, span := range valid {
+		if span.start.Before(first) {
+			first = span.start
 		}
+		if span.end.After(last) {
+			last = span.end
+		}
+	}
+	stored, err := s.Repo.GetAvailabilities(ctx, req.ProviderId, first, last)
+	if err != nil {
+		return nil, storageError(err)
+	}
 
-		// Check if availability already exists for this timeframe
-		var existingAvailability models.Availability
-		err = storage.DB.First(&existingAvailability, "provider_id = ? AND start_time = ? AND end_time = ?", req.ProviderId, startTime, endTime)
-		if err == nil {
-			// Skip this time slot if availability already exists
+	// Work out what each time slot creates, merges or is rejected for
+	plan := newAvailabilityPlan(req.ProviderId, mode, settings, stored)
+	invalid := make(map[int]string)
+	for _, timeSlotErr := range timeSlotErrors {
+		invalid[int(timeSlotErr.Index)] = timeSlotErr.Message
+	}
+	for i, span := range spans {
+		if span == nil {
+			plan.addInvalid(invalid[i])
 			continue
 		}
+		plan.add(*span)
+	}
 
-		// Create a new availability entry
-		availability := models.Availability{
-			ID:         generateID(),
-			ProviderID: req.ProviderId,
-			StartTime:  startTime,
-			EndTime:    endTime,
+	// Save new and merged availabilities and their slots to the database
+	merges, availabilities, slots := plan.changes()
+	if len(merges) > 0 || len(availabilities) > 0 || len(slots) > 0 {
+		err = s.Repo.AddAvailabilityAndSlots(ctx, req.ProviderId, merges, availabilities, slots)
+		if err != nil {
+			return nil, storageError(err)
 		}
-		availabilityMap[fmt.Sprintf("%s-%s", startTime, endTime)] = availability
-
-		// Split the interval into 15-minute slots
-		for t := startTime; t.Before(endTime); t = t.Add(15 * time.Minute) {
-			// Check if slot already exists for this timeframe
-			var existingSlot models.Slot
-			err = storage.DB.First(&existingSlot, "availability_id = ? AND start_time = ? AND end_time = ?", availability.ID, t, t.Add(15*time.Minute))
-			if err == nil {
-				// Skip this slot if it already exists
-				continue
-			}
+		s.Metrics.SlotsCreated(metrics.SourceSetAvailability, len(slots))
+	}
 
-			slots = append(slots, models.Slot{
-				ID:             generateID(),
-				AvailabilityID: availability.ID,
-				StartTime:      t,
-				EndTime:        t.Add(15 * time.Minute),
-				Status:         "Available",
-			})
-		}
+	message := "Availability set successfully"
+	if len(timeSlotErrors) > 0 {
+		message = fmt.Sprintf("Availability set, %d of %d time slots are invalid", len(timeSlotErrors), len(req.TimeSlots))
 	}
+	return &pb.SetAvailabilityResponse{
+		Message: message,
+		Results: plan.response(),
+		Errors:  timeSlotErrors,
+	}, nil
+}
 
-	// Save new availabilities and slots to the database
-	availabilities := make([]models.Availability, 0, len(availabilityMap))
-	for _, availability := range availabilityMap {
-		availabilities = append(availabilities, availability)
+// slotSettings are a provider's slot generation and booking settings.
+type slotSettings struct {
+	duration     time.Duration
+	bufferBefore time.Duration
+	bufferAfter  time.Duration
+	minLeadTime  time.Duration
+}
+
+// step returns the time taken by each slot and its buffers.
+func (s slotSettings) step() time.Duration {
+	return s.bufferBefore + s.duration + s.bufferAfter
+}
+
+// providerSettings returns the slot settings of a provider, falling back to the
+// defaults for the values it has not set.
+func (s *ReservationService) providerSettings(provider models.Provider) slotSettings {
+	settings := slotSettings{
+		duration:     time.Duration(provider.SlotDurationMinutes) * time.Minute,
+		bufferBefore: time.Duration(provider.BufferBeforeMinutes) * time.Minute,
+		bufferAfter:  time.Duration(provider.BufferAfterMinutes) * time.Minute,
+		minLeadTime:  s.MinLeadTime,
+	}
+	if settings.duration == 0 {
+		settings.duration = DefaultSlotDuration
 	}
+	if provider.MinLeadTimeMinutes != nil {
+		settings.minLeadTime = time.Duration(*provider.MinLeadTimeMinutes) * time.Minute
+	}
+	return settings
+}
 
-	if len(availabilities) > 0 || len(slots) > 0 {
-		err = storage.AddAvailabilityAndSlots(req.ProviderId, availabilities, slots)
-		if err != nil {
-			return nil, err
+// validateProviderSettings checks the slot settings of a create or update
+// request. Settings the request leaves out are nil.
+func validateProviderSettings(slotDuration, bufferBefore, bufferAfter, minLeadTime *int32) error {
+	settings := []struct {
+		name  string
+		value *int32
+	}{
+		{"slot_duration_minutes", slotDuration},
+		{"buffer_before_minutes", bufferBefore},
+		{"buffer_after_minutes", bufferAfter},
+		{"min_lead_time_minutes", minLeadTime},
+	}
+	for _, setting := range settings {
+		if setting.value != nil && *setting.value < 0 {
+			return invalidArgument(setting.name, "slot settings must not be negative")
 		}
 	}
+	if slotDuration != nil && *slotDuration == 0 {
+		return invalidArgument("slot_duration_minutes", "slot duration must be positive")
+	}
+	return nil
+}
+
+// optionalMinutes converts an optional request setting to the model's, keeping
+// nil for a setting the request leaves out.
+func optionalMinutes(value *int32) *int {
+	if value == nil {
+		return nil
+	}
+	minutes := int(*value)
+	return &minutes
+}
+
+// splitIntoSlots splits an availability window into Available slots. Each slot
+// is surrounded by the provider's buffers and must fit inside the window.
+func splitIntoSlots(availability models.Availability, settings slotSettings) []models.Slot {
+	var slots []models.Slot
+	step := settings.step()
+	for t := availability.StartTime; !t.Add(step).After(availability.EndTime); t = t.Add(step) {
+		start := t.Add(settings.bufferBefore)
+		slots = append(slots, models.Slot{
+			ID:             generateID(),
+			AvailabilityID: availability.ID,
+			StartTime:      start,
+			EndTime:        start.Add(settings.duration),
+			Status:         "Available",
+		})
+	}
+	return slots
+}
+
+// formatDuration formats a duration as whole hours or minutes for error messages.
+func formatDuration(d time.Duration) string {
+	switch {
+	case d == time.Hour:
+		return "1 hour"
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%d hours", d/time.Hour)
+	case d == time.Minute:
+		return "1 minute"
+	default:
+		return fmt.Sprintf("%d minutes", d/time.Minute)
+	}
+}
+
+// loadLocation returns the named IANA time zone, or UTC when name is empty.
+func loadLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, invalidArgument("time_zone", "invalid time zone: "+name)
+	}
+	return loc, nil
+}
+
+// queryLocation returns the time zone a query's dates and times are expressed
+// in: the client's when given, otherwise the provider's.
+func queryLocation(clientTimeZone string, provider models.Provider) (*time.Location, error) {
+	if clientTimeZone != "" {
+		return loadLocation(clientTimeZone)
+	}
+	return loadLocation(provider.TimeZone)
+}
 
-	return &pb.SetAvailabilityResponse{Message: "Availability set successfully"}, nil
+// parseDate parses a "YYYY-MM-DD" date as midnight in loc.
+func parseDate(date string, loc *time.Location) (time.Time, error) {
+	parsed, err := time.ParseInLocation("2006-01-02", date, loc)
+	if err != nil {
+		return time.Time{}, invalidArgument("date", "invalid date format")
+	}
+	return parsed, nil
 }
 
 func (s *ReservationService) GetAvailableSlots(ctx context.Context, req *pb.GetAvailableSlotsRequest) (*pb.GetAvailableSlotsResponse, error) {
-	// Parse the requested date
-	date, err := time.Parse("2006-01-02", req.Date)
+	if err := authenticated(ctx); err != nil {
+		return nil, err
+	}
+
+	// Validate that the provider exists
+	provider, err := s.Repo.GetProvider(ctx, req.ProviderId)
 	if err != nil {
-		return nil, errors.New("invalid date format")
+		return nil, lookupError(err, "provider", req.ProviderId)
 	}
 
-	// Query the database for slots
-	slots, err := storage.GetAvailableSlots(req.ProviderId, date)
+	// Parse the requested date in the client's or provider's time zone
+	loc, err := queryLocation(req.TimeZone, provider)
+	if err != nil {
+		return nil, err
+	}
+	date, err := parseDate(req.Date, loc)
 	if err != nil {
 		return nil, err
 	}
 
+	// Inactive providers cannot be booked, so they show no slots
+	if !provider.Active {
+		return &pb.GetAvailableSlotsResponse{}, nil
+	}
+
+	// Query the database for slots
+	slots, err := s.Repo.GetAvailableSlots(ctx, req.ProviderId, date)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	// Only keep start times with room for the whole appointment
+	if req.AppointmentTypeId != "" {
+		count, maxGap, err := s.bookingShape(ctx, req.ProviderId, req.AppointmentTypeId)
+		if err != nil {
+			return nil, err
+		}
+		slots = availableRuns(slots, count, maxGap)
+	}
+
 	// Convert database results to protobuf response
 	var pbSlots []*pb.TimeSlot
 	for _, slot := range slots {
 		pbSlots = append(pbSlots, &pb.TimeSlot{
 			Id:        slot.ID,
-			StartTime: slot.StartTime.Format(time.RFC3339),
-			EndTime:   slot.EndTime.Format(time.RFC3339),
+			StartTime: slot.StartTime.In(loc).Format(time.RFC3339),
+			EndTime:   slot.EndTime.In(loc).Format(time.RFC3339),
 			Status:    slot.Status,
 		})
 	}
@@ -126,63 +353,269 @@ func (s *ReservationService) GetAvailableSlots(ctx context.Context, req *pb.GetA
 }
 
 func (s *ReservationService) ReserveSlot(ctx context.Context, req *pb.ReserveSlotRequest) (*pb.ReserveSlotResponse, error) {
+	resp, err := idempotent(ctx, s, "ReserveSlot", req, func() (*pb.ReserveSlotResponse, error) {
+		return s.reserveSlot(ctx, req)
+	}, withoutHoldToken)
+	if err != nil || resp.HoldToken != "" {
+		return resp, err
+	}
+
+	// A replayed response is stored without its hold token, which is only kept
+	// hashed, so issue a new one in its place
+	return s.reissueHoldToken(ctx, resp)
+}
+
+// reserveSlot holds the slots of a ReserveSlot request, once for each
+// idempotency key.
+func (s *ReservationService) reserveSlot(ctx context.Context, req *pb.ReserveSlotRequest) (*pb.ReserveSlotResponse, error) {
+	if err := authorize(ctx, auth.Client(req.ClientId)); err != nil {
+		return nil, err
+	}
+
+	// Only registered clients may book
+	if _, err := s.Repo.GetClient(ctx, req.ClientId); err != nil {
+		return nil, lookupError(err, "client", req.ClientId)
+	}
+
 	// Fetch the slot to validate
-	var slot models.Slot
-	err := storage.DB.First(&slot, "id = ? AND status = ?", req.SlotId, "Available")
+	slot, err := s.Repo.GetAvailableSlot(ctx, req.SlotId)
 	if err != nil {
-		return nil, errors.New("slot is not available")
+		return nil, slotLookupError(err, req.SlotId)
 	}
 
-	// Validate 24-hour rule
-	if slot.StartTime.Before(time.Now().Add(24 * time.Hour)) {
-		return nil, errors.New("reservations must be made at least 24 hours in advance")
+	// Validate that the provider is active and the lead time
+	if err := s.validateBooking(ctx, slot); err != nil {
+		return nil, err
 	}
 
-	// Reserve the slot
-	expiration := time.Now().Add(30 * time.Minute)
-	err = storage.ReserveSlot(req.SlotId, req.ClientId, slot.ProviderID, expiration)
+	// Work out how many consecutive slots the appointment needs
+	count, maxGap, err := s.bookingShape(ctx, slot.ProviderID, req.AppointmentTypeId)
 	if err != nil {
 		return nil, err
 	}
 
+	// Reserve the slots, keeping only a hash of the hold token
+	holdToken, err := newHoldToken()
+	if err != nil {
+		return nil, twirp.InternalErrorWith(err)
+	}
+	expiration := time.Now().UTC().Add(s.HoldExpiry)
+	reservation := models.Reservation{
+		ID:                generateID(),
+		ClientID:          req.ClientId,
+		AppointmentTypeID: req.AppointmentTypeId,
+		ReservationExpiry: &expiration,
+		HoldTokenHash:     hashHoldToken(holdToken),
+		Status:            "Reserved",
+	}
+	err = s.Repo.ReserveSlot(ctx, reservation, req.SlotId, count, maxGap)
+	if err != nil {
+		return nil, storageError(err)
+	}
+	s.Metrics.ReservationCreated()
+
 	return &pb.ReserveSlotResponse{
-		ReservationId: req.SlotId,
+		ReservationId: reservation.ID,
 		Message:       "Slot reserved successfully",
+		HoldToken:     holdToken,
+		HoldExpiresAt: expiration.Format(time.RFC3339),
 	}, nil
 }
 
+// validateBooking checks that the provider of a slot is active and enforces
+// their minimum lead time for booking it.
+func (s *ReservationService) validateBooking(ctx context.Context, slot models.Slot) error {
+	provider, err := s.Repo.GetProvider(ctx, slot.ProviderID)
+	if err != nil {
+		return lookupError(err, "provider", slot.ProviderID)
+	}
+	if !provider.Active {
+		return failedPrecondition("provider is not active").WithMeta("provider_id", provider.ID)
+	}
+
+	minLeadTime := s.providerSettings(provider).minLeadTime
+	if slot.StartTime.Before(time.Now().Add(minLeadTime)) {
+		return failedPrecondition(fmt.Sprintf("reservations must be made at least %s in advance", formatDuration(minLeadTime)))
+	}
+	return nil
+}
+
 func (s *ReservationService) ConfirmReservation(ctx context.Context, req *pb.ConfirmReservationRequest) (*pb.ConfirmReservationResponse, error) {
-	// Confirm the reservation in the database
-	err := storage.ConfirmReservation(req.ReservationId)
+	if err := authenticated(ctx); err != nil {
+		return nil, err
+	}
+
+	// Fetch the reservation to check who may confirm it
+	reservation, err := s.Repo.GetReservation(ctx, req.ReservationId)
 	if err != nil {
+		return nil, lookupError(err, "reservation", req.ReservationId)
+	}
+	if err := authorizeReservation(ctx, reservation); err != nil {
+		return nil, err
+	}
+	if err := checkHoldToken(reservation, req.HoldToken); err != nil {
 		return nil, err
 	}
 
+	// Confirm the reservation in the database, unless its hold has expired
+	err = s.Repo.ConfirmReservation(ctx, req.ReservationId)
+	if err != nil {
+		return nil, storageError(err)
+	}
+	s.Metrics.ReservationConfirmed()
+
 	return &pb.ConfirmReservationResponse{Message: "Reservation confirmed"}, nil
 }
 
+func (s *ReservationService) CancelReservation(ctx context.Context, req *pb.CancelReservationRequest) (*pb.CancelReservationResponse, error) {
+	if err := authenticated(ctx); err != nil {
+		return nil, err
+	}
+
+	// Fetch the reservation to validate
+	reservation, err := s.Repo.GetReservation(ctx, req.ReservationId)
+	if err != nil {
+		return nil, lookupError(err, "reservation", req.ReservationId)
+	}
+	if err := authorizeReservation(ctx, reservation); err != nil {
+		return nil, err
+	}
+
+	// Validate the cancellation cutoff window
+	if reservation.StartTime.Before(time.Now().Add(s.CancellationCutoff)) {
+		return nil, failedPrecondition(fmt.Sprintf("reservations must be cancelled at least %s in advance", formatDuration(s.CancellationCutoff)))
+	}
+
+	// Cancel the reservation and release its slot
+	err = s.Repo.CancelReservation(ctx, models.Cancellation{
+		ID:            generateID(),
+		ReservationID: reservation.ID,
+		CancelledBy:   callerName(ctx),
+		Reason:        req.Reason,
+		CancelledAt:   time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, storageError(err)
+	}
+	s.Metrics.ReservationCancelled()
+
+	// A cancelled waitlist offer is declined, and the freed slots go to the
+	// waitlist
+	if err := s.Repo.CloseWaitlistOffer(ctx, reservation.ID, models.WaitlistDeclined); err != nil {
+		slog.WarnContext(ctx, "Failed to close waitlist offer", "reservation_id", reservation.ID, "error", err)
+	}
+	s.offerFreedSlots(ctx, reservation)
+
+	return &pb.CancelReservationResponse{Message: "Reservation cancelled"}, nil
+}
+
+// CleanupExpiredReservations releases the holds that were not confirmed in
+// time and offers their slots to the waitlist. Waitlist offers that lapsed
+// expire their entries, and so do entries whose last day has passed.
+func (s *ReservationService) CleanupExpiredReservations(ctx context.Context) error {
+	released, err := s.Repo.CleanupExpiredReservations(ctx)
+	s.Metrics.ReservationsExpired(len(released))
+	if err != nil {
+		return err
+	}
+
+	for _, reservation := range released {
+		if err := s.Repo.CloseWaitlistOffer(ctx, reservation.ID, models.WaitlistExpired); err != nil {
+			return err
+		}
+		s.offerFreedSlots(ctx, reservation)
+	}
+	return s.expireStaleWaitlistEntries(ctx)
+}
+
+func (s *ReservationService) RescheduleReservation(ctx context.Context, req *pb.RescheduleReservationRequest) (*pb.RescheduleReservationResponse, error) {
+	if err := authenticated(ctx); err != nil {
+		return nil, err
+	}
+
+	// Fetch the reservation to validate
+	reservation, err := s.Repo.GetReservation(ctx, req.ReservationId)
+	if err != nil {
+		return nil, lookupError(err, "reservation", req.ReservationId)
+	}
+	if err := authorizeReservation(ctx, reservation); err != nil {
+		return nil, err
+	}
+
+	// Fetch the new slot to validate. A reservation stays with its provider,
+	// who authorized it
+	slot, err := s.Repo.GetAvailableSlot(ctx, req.NewSlotId)
+	if err != nil {
+		return nil, slotLookupError(err, req.NewSlotId)
+	}
+	if slot.ProviderID != reservation.ProviderID {
+		return nil, failedPrecondition("new slot must belong to the reservation's provider").WithMeta("slot_id", slot.ID)
+	}
+
+	// Validate that the provider is active and the lead time
+	if err := s.validateBooking(ctx, slot); err != nil {
+		return nil, err
+	}
+
+	// Keep the same appointment length at the new time
+	count, maxGap, err := s.bookingShape(ctx, slot.ProviderID, reservation.AppointmentTypeID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Swap the slots
+	err = s.Repo.RescheduleReservation(ctx, reservation.ID, slot.ID, count, maxGap)
+	if err != nil {
+		return nil, storageError(err)
+	}
+	s.Metrics.ReservationRescheduled()
+
+	return &pb.RescheduleReservationResponse{
+		ReservationId: reservation.ID,
+		Message:       "Reservation rescheduled successfully",
+	}, nil
+}
+
 func (s *ReservationService) CreateProvider(ctx context.Context, req *pb.CreateProviderRequest) (*pb.CreateProviderResponse, error) {
+	if err := authorize(ctx); err != nil {
+		return nil, err
+	}
+
 	// Check if the provider already exists
-	var existingProvider models.Provider
-	err := storage.DB.First(&existingProvider, "id = ?", req.Id)
-	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+	_, err := s.Repo.GetProvider(ctx, req.Id)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
 		// Return error if it is not a "record not found" error
-		return nil, errors.New("failed to query provider")
+		return nil, storageError(err)
 	}
 	if err == nil {
 		// If no error, the provider already exists
-		return nil, errors.New("provider already exists")
+		return nil, twirp.NewError(twirp.AlreadyExists, "provider already exists").WithMeta("provider_id", req.Id)
 	}
 
-	// Create a new provider
+	// Validate the slot settings and time zone
+	err = validateProviderSettings(req.SlotDurationMinutes, req.BufferBeforeMinutes, req.BufferAfterMinutes, req.MinLeadTimeMinutes)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := loadLocation(req.TimeZone); err != nil {
+		return nil, err
+	}
+
+	// Create a new provider. Settings left out fall back to the defaults
 	provider := models.Provider{
-		ID:   req.Id,
-		Name: req.Name,
+		ID:                  req.Id,
+		Name:                req.Name,
+		SlotDurationMinutes: int(req.GetSlotDurationMinutes()),
+		BufferBeforeMinutes: int(req.GetBufferBeforeMinutes()),
+		BufferAfterMinutes:  int(req.GetBufferAfterMinutes()),
+		MinLeadTimeMinutes:  optionalMinutes(req.MinLeadTimeMinutes),
+		TimeZone:            req.TimeZone,
+		Active:              true,
 	}
 
-	err = storage.DB.Create(&provider)
+	err = s.Repo.CreateProvider(ctx, provider)
 	if err != nil {
-		return nil, errors.New("failed to create provider")
+		return nil, storageError(err)
 	}
 
 	return &pb.CreateProviderResponse{
@@ -191,46 +624,215 @@ func (s *ReservationService) CreateProvider(ctx context.Context, req *pb.CreateP
 }
 
 func (s *ReservationService) GetProvider(ctx context.Context, req *pb.GetProviderRequest) (*pb.GetProviderResponse, error) {
+	if err := authenticated(ctx); err != nil {
+		return nil, err
+	}
+
 	// Retrieve the provider data
-	var provider models.Provider
-	err := storage.DB.First(&provider, "id = ?", req.Id)
+	provider, err := s.Repo.GetProvider(ctx, req.Id)
 	if err != nil {
-		return nil, errors.New("provider not found")
+		return nil, lookupError(err, "provider", req.Id)
 	}
 
+	settings := s.providerSettings(provider)
 	return &pb.GetProviderResponse{
-		Id:   provider.ID,
-		Name: provider.Name,
+		Id:                  provider.ID,
+		Name:                provider.Name,
+		SlotDurationMinutes: int32(settings.duration / time.Minute),
+		BufferBeforeMinutes: int32(settings.bufferBefore / time.Minute),
+		BufferAfterMinutes:  int32(settings.bufferAfter / time.Minute),
+		MinLeadTimeMinutes:  int32(settings.minLeadTime / time.Minute),
+		TimeZone:            provider.TimeZone,
+		Active:              provider.Active,
 	}, nil
 }
 
+func (s *ReservationService) UpdateProvider(ctx context.Context, req *pb.UpdateProviderRequest) (*pb.UpdateProviderResponse, error) {
+	if err := authorize(ctx, auth.Provider(req.Id)); err != nil {
+		return nil, err
+	}
+
+	// Validate that the provider exists
+	provider, err := s.Repo.GetProvider(ctx, req.Id)
+	if err != nil {
+		return nil, lookupError(err, "provider", req.Id)
+	}
+
+	// Validate the new values
+	if req.Name != nil && *req.Name == "" {
+		return nil, invalidArgument("name", "name must not be empty")
+	}
+	err = validateProviderSettings(req.SlotDurationMinutes, req.BufferBeforeMinutes, req.BufferAfterMinutes, req.MinLeadTimeMinutes)
+	if err != nil {
+		return nil, err
+	}
+	if req.TimeZone != nil {
+		if _, err := loadLocation(*req.TimeZone); err != nil {
+			return nil, err
+		}
+	}
+
+	// Replace the fields the request sets, keeping the others
+	if req.Name != nil {
+		provider.Name = *req.Name
+	}
+	if req.SlotDurationMinutes != nil {
+		provider.SlotDurationMinutes = int(*req.SlotDurationMinutes)
+	}
+	if req.BufferBeforeMinutes != nil {
+		provider.BufferBeforeMinutes = int(*req.BufferBeforeMinutes)
+	}
+	if req.BufferAfterMinutes != nil {
+		provider.BufferAfterMinutes = int(*req.BufferAfterMinutes)
+	}
+	if req.MinLeadTimeMinutes != nil {
+		provider.MinLeadTimeMinutes = optionalMinutes(req.MinLeadTimeMinutes)
+	}
+	if req.TimeZone != nil {
+		provider.TimeZone = *req.TimeZone
+	}
+
+	err = s.Repo.UpdateProvider(ctx, provider)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	return &pb.UpdateProviderResponse{
+		Message: "Provider updated successfully",
+	}, nil
+}
+
+func (s *ReservationService) DeactivateProvider(ctx context.Context, req *pb.DeactivateProviderRequest) (*pb.DeactivateProviderResponse, error) {
+	if err := authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	// Stop new bookings first, so no reservation slips in while cancelling
+	err := s.Repo.DeactivateProvider(ctx, req.Id)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	cancelled := 0
+	if req.CancelFutureReservations {
+		reason := req.Reason
+		if reason == "" {
+			reason = "Provider deactivated"
+		}
+
+		reservations, err := s.Repo.GetReservationsByProvider(ctx, req.Id, nil)
+		if err != nil {
+			return nil, storageError(err)
+		}
+		now := time.Now().UTC()
+		for _, reservation := range reservations {
+			if !reservation.StartTime.After(now) {
+				continue
+			}
+			// The cancellation cutoff does not apply, the provider is leaving
+			err = s.Repo.CancelReservation(ctx, models.Cancellation{
+				ID:            generateID(),
+				ReservationID: reservation.ID,
+				CancelledBy:   callerName(ctx),
+				Reason:        reason,
+				CancelledAt:   now,
+			})
+			if errors.Is(err, storage.ErrNotFound) {
+				// Cancelled or expired in the meantime
+				continue
+			}
+			if err != nil {
+				return nil, storageError(err)
+			}
+			s.Metrics.ReservationCancelled()
+			cancelled++
+
+			// A waitlist offer of the provider can no longer be accepted
+			if err := s.Repo.CloseWaitlistOffer(ctx, reservation.ID, models.WaitlistExpired); err != nil {
+				return nil, storageError(err)
+			}
+		}
+	}
+
+	return &pb.DeactivateProviderResponse{
+		Message:               "Provider deactivated",
+		CancelledReservations: int32(cancelled),
+	}, nil
+}
+
+func (s *ReservationService) ListProviders(ctx context.Context, req *pb.ListProvidersRequest) (*pb.ListProvidersResponse, error) {
+	if err := authenticated(ctx); err != nil {
+		return nil, err
+	}
+
+	providers, err := s.Repo.ListProviders(ctx, req.IncludeInactive)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	// Convert database results to protobuf response
+	var pbProviders []*pb.Provider
+	for _, provider := range providers {
+		settings := s.providerSettings(provider)
+		pbProviders = append(pbProviders, &pb.Provider{
+			Id:                  provider.ID,
+			Name:                provider.Name,
+			SlotDurationMinutes: int32(settings.duration / time.Minute),
+			BufferBeforeMinutes: int32(settings.bufferBefore / time.Minute),
+			BufferAfterMinutes:  int32(settings.bufferAfter / time.Minute),
+			MinLeadTimeMinutes:  int32(settings.minLeadTime / time.Minute),
+			TimeZone:            provider.TimeZone,
+			Active:              provider.Active,
+		})
+	}
+
+	return &pb.ListProvidersResponse{Providers: pbProviders}, nil
+}
+
 func (s *ReservationService) GetReservedSlotsByProvider(ctx context.Context, req *pb.GetReservedSlotsByProviderRequest) (*pb.GetReservedSlotsByProviderResponse, error) {
+	if err := authorize(ctx, auth.Provider(req.ProviderId)); err != nil {
+		return nil, err
+	}
+
+	// Validate that the provider exists
+	provider, err := s.Repo.GetProvider(ctx, req.ProviderId)
+	if err != nil {
+		return nil, lookupError(err, "provider", req.ProviderId)
+	}
+
+	// Dates and times are in the client's or provider's time zone
+	loc, err := queryLocation(req.TimeZone, provider)
+	if err != nil {
+		return nil, err
+	}
+
 	// Parse the optional date argument
 	var date *time.Time
 	if req.Date != "" {
-		parsedDate, err := time.Parse("2006-01-02", req.Date)
+		parsedDate, err := parseDate(req.Date, loc)
 		if err != nil {
-			return nil, errors.New("invalid date format")
+			return nil, err
 		}
 		date = &parsedDate
 	}
 
 	// Query for reservations
-	reservations, err := storage.GetReservationsByProvider(req.ProviderId, date)
+	reservations, err := s.Repo.GetReservationsByProvider(ctx, req.ProviderId, date)
 	if err != nil {
-		return nil, err
+		return nil, storageError(err)
 	}
 
 	// Convert to protobuf response
 	var pbReservations []*pb.ReservationDetails
 	for _, reservation := range reservations {
 		pbReservations = append(pbReservations, &pb.ReservationDetails{
-			ReservationId: reservation.ID,
-			ClientId:      reservation.ClientID,
-			ProviderId:    req.ProviderId,
-			Status:        reservation.Status,
-			StartTime:     reservation.StartTime.Format(time.RFC3339),
-			EndTime:       reservation.EndTime.Format(time.RFC3339),
+			ReservationId:     reservation.ID,
+			ClientId:          reservation.ClientID,
+			ProviderId:        req.ProviderId,
+			Status:            reservation.Status,
+			StartTime:         reservation.StartTime.In(loc).Format(time.RFC3339),
+			EndTime:           reservation.EndTime.In(loc).Format(time.RFC3339),
+			AppointmentTypeId: reservation.AppointmentTypeID,
 		})
 	}
 
@@ -238,32 +840,58 @@ func (s *ReservationService) GetReservedSlotsByProvider(ctx context.Context, req
 }
 
 func (s *ReservationService) GetReservedSlotsByClient(ctx context.Context, req *pb.GetReservedSlotsByClientRequest) (*pb.GetReservedSlotsByClientResponse, error) {
+	if err := authorize(ctx, auth.Client(req.ClientId)); err != nil {
+		return nil, err
+	}
+
+	// Dates are in the client's time zone, or UTC if not given
+	clientLoc, err := loadLocation(req.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
 	// Parse the optional date argument
 	var date *time.Time
 	if req.Date != "" {
-		parsedDate, err := time.Parse("2006-01-02", req.Date)
+		parsedDate, err := parseDate(req.Date, clientLoc)
 		if err != nil {
-			return nil, errors.New("invalid date format")
+			return nil, err
 		}
 		date = &parsedDate
 	}
 
 	// Query for reservations
-	reservations, err := storage.GetReservationsByClient(req.ClientId, date)
+	reservations, err := s.Repo.GetReservationsByClient(ctx, req.ClientId, date)
 	if err != nil {
-		return nil, err
+		return nil, storageError(err)
 	}
 
-	// Convert to protobuf response
+	// Convert to protobuf response, with times in the client's time zone or
+	// else in each provider's
+	locations := make(map[string]*time.Location)
 	var pbReservations []*pb.ReservationDetails
 	for _, reservation := range reservations {
+		loc, ok := locations[reservation.ProviderID]
+		if !ok {
+			provider, err := s.Repo.GetProvider(ctx, reservation.ProviderID)
+			if err != nil {
+				return nil, lookupError(err, "provider", reservation.ProviderID)
+			}
+			loc, err = queryLocation(req.TimeZone, provider)
+			if err != nil {
+				return nil, err
+			}
+			locations[reservation.ProviderID] = loc
+		}
+
 		pbReservations = append(pbReservations, &pb.ReservationDetails{
-			ReservationId: reservation.ID,
-			ClientId:      req.ClientId,
-			ProviderId:    reservation.ProviderID,
-			Status:        reservation.Status,
-			StartTime:     reservation.StartTime.Format(time.RFC3339),
-			EndTime:       reservation.EndTime.Format(time.RFC3339),
+			ReservationId:     reservation.ID,
+			ClientId:          req.ClientId,
+			ProviderId:        reservation.ProviderID,
+			Status:            reservation.Status,
+			StartTime:         reservation.StartTime.In(loc).Format(time.RFC3339),
+			EndTime:           reservation.EndTime.In(loc).Format(time.RFC3339),
+			AppointmentTypeId: reservation.AppointmentTypeID,
 		})
 	}
 
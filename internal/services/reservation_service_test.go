@@ -0,0 +1,511 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/manueldelreal/health-reservation-system/api"
+	"github.com/manueldelreal/health-reservation-system/internal/auth"
+	"github.com/manueldelreal/health-reservation-system/internal/models"
+	"github.com/manueldelreal/health-reservation-system/internal/storage"
+)
+
+// recordingRepository is an in-memory repository that keeps the cancellations
+// it is asked to record.
+type recordingRepository struct {
+	storage.Repository
+	cancellations []models.Cancellation
+}
+
+func (r *recordingRepository) CancelReservation(ctx context.Context, cancellation models.Cancellation) error {
+	r.cancellations = append(r.cancellations, cancellation)
+	return r.Repository.CancelReservation(ctx, cancellation)
+}
+
+// testBooking is a service over an in-memory repository with two providers,
+// p1 and p2, each available from 09:00 to 11:00 UTC in 30-minute slots three
+// days from now, and two clients, c1 and c2.
+type testBooking struct {
+	service *ReservationService
+	repo    *recordingRepository
+	day     string
+}
+
+func newTestBooking(t *testing.T) *testBooking {
+	t.Helper()
+	repo := &recordingRepository{Repository: storage.NewMemoryRepository()}
+	b := &testBooking{
+		service: &ReservationService{
+			Repo:               repo,
+			HoldExpiry:         30 * time.Minute,
+			MinLeadTime:        time.Hour,
+			CancellationCutoff: time.Hour,
+			MaxHoldExtensions:  2,
+		},
+		repo: repo,
+		day:  time.Now().UTC().AddDate(0, 0, 3).Format(time.DateOnly),
+	}
+
+	admin := asAdmin()
+	for _, providerID := range []string{"p1", "p2"} {
+		_, err := b.service.CreateProvider(admin, &pb.CreateProviderRequest{Id: providerID, Name: providerID, SlotDurationMinutes: proto.Int32(30)})
+		if err != nil {
+			t.Fatalf("CreateProvider %s: %v", providerID, err)
+		}
+		resp, err := b.service.SetAvailability(admin, &pb.SetAvailabilityRequest{
+			ProviderId: providerID,
+			TimeSlots: []*pb.TimeSlot{{
+				StartTime: b.day + "T09:00:00Z",
+				EndTime:   b.day + "T11:00:00Z",
+			}},
+		})
+		if err != nil || len(resp.Errors) > 0 {
+			t.Fatalf("SetAvailability %s: %v %v", providerID, err, resp.GetErrors())
+		}
+	}
+	for _, clientID := range []string{"c1", "c2"} {
+		if _, err := b.service.CreateClient(admin, &pb.CreateClientRequest{Id: clientID, Name: clientID}); err != nil {
+			t.Fatalf("CreateClient %s: %v", clientID, err)
+		}
+	}
+	return b
+}
+
+// slots returns the IDs of a provider's available slots on the test day.
+func (b *testBooking) slots(t *testing.T, providerID string) []string {
+	t.Helper()
+	resp, err := b.service.GetAvailableSlots(asAdmin(), &pb.GetAvailableSlotsRequest{ProviderId: providerID, Date: b.day})
+	if err != nil {
+		t.Fatalf("GetAvailableSlots %s: %v", providerID, err)
+	}
+	var ids []string
+	for _, slot := range resp.Slots {
+		ids = append(ids, slot.Id)
+	}
+	if len(ids) == 0 {
+		t.Fatalf("provider %s has no available slots", providerID)
+	}
+	return ids
+}
+
+// reserve holds a slot for a client and returns the response.
+func (b *testBooking) reserve(t *testing.T, clientID, slotID string) *pb.ReserveSlotResponse {
+	t.Helper()
+	resp, err := b.service.ReserveSlot(asClient(clientID), &pb.ReserveSlotRequest{SlotId: slotID, ClientId: clientID})
+	if err != nil {
+		t.Fatalf("ReserveSlot: %v", err)
+	}
+	return resp
+}
+
+func asAdmin() context.Context {
+	return auth.NewContext(context.Background(), auth.Principal{Role: auth.RoleAdmin})
+}
+
+func asClient(clientID string) context.Context {
+	return auth.NewContext(context.Background(), auth.Client(clientID))
+}
+
+func asProvider(providerID string) context.Context {
+	return auth.NewContext(context.Background(), auth.Provider(providerID))
+}
+
+// wantCode fails the test unless err is a Twirp error with the given code.
+func wantCode(t *testing.T, err error, code twirp.ErrorCode) {
+	t.Helper()
+	var twerr twirp.Error
+	if !errors.As(err, &twerr) || twerr.Code() != code {
+		t.Fatalf("got error %v, want %s", err, code)
+	}
+}
+
+func TestProviderLeadTimeMayBeZero(t *testing.T) {
+	b := newTestBooking(t)
+	admin := asAdmin()
+	for id, leadTime := range map[string]*int32{"default": nil, "walk-in": proto.Int32(0)} {
+		_, err := b.service.CreateProvider(admin, &pb.CreateProviderRequest{Id: id, Name: id, SlotDurationMinutes: proto.Int32(30), MinLeadTimeMinutes: leadTime})
+		if err != nil {
+			t.Fatalf("CreateProvider %s: %v", id, err)
+		}
+	}
+
+	// A provider without a lead time gets the server default, and one with
+	// zero keeps it
+	for id, want := range map[string]int32{"default": 60, "walk-in": 0} {
+		provider, err := b.service.GetProvider(admin, &pb.GetProviderRequest{Id: id})
+		if err != nil {
+			t.Fatalf("GetProvider %s: %v", id, err)
+		}
+		if provider.MinLeadTimeMinutes != want {
+			t.Fatalf("provider %s has a lead time of %d minutes, want %d", id, provider.MinLeadTimeMinutes, want)
+		}
+	}
+
+	// Only the walk-in provider can be booked for a slot starting in minutes
+	start := time.Now().UTC().Truncate(time.Minute).Add(10 * time.Minute)
+	for _, id := range []string{"default", "walk-in"} {
+		resp, err := b.service.SetAvailability(admin, &pb.SetAvailabilityRequest{
+			ProviderId: id,
+			TimeSlots:  []*pb.TimeSlot{{StartTime: start.Format(time.RFC3339), EndTime: start.Add(30 * time.Minute).Format(time.RFC3339)}},
+		})
+		if err != nil || len(resp.Errors) > 0 {
+			t.Fatalf("SetAvailability %s: %v %v", id, err, resp.GetErrors())
+		}
+	}
+	slotOf := func(id string) string {
+		slots := b.slotsOn(t, id, start.Format(time.DateOnly))
+		if len(slots) != 1 {
+			t.Fatalf("provider %s has slots %v, want one", id, slots)
+		}
+		return slots[0]
+	}
+	_, err := b.service.ReserveSlot(asClient("c1"), &pb.ReserveSlotRequest{SlotId: slotOf("default"), ClientId: "c1"})
+	wantCode(t, err, twirp.FailedPrecondition)
+	b.reserve(t, "c1", slotOf("walk-in"))
+}
+
+func TestUpdateProviderKeepsFieldsLeftOut(t *testing.T) {
+	b := newTestBooking(t)
+	ctx := asProvider("p1")
+
+	_, err := b.service.UpdateProvider(ctx, &pb.UpdateProviderRequest{Id: "p1", Name: proto.String("Dr. One")})
+	if err != nil {
+		t.Fatalf("UpdateProvider: %v", err)
+	}
+	provider, err := b.service.GetProvider(ctx, &pb.GetProviderRequest{Id: "p1"})
+	if err != nil {
+		t.Fatalf("GetProvider: %v", err)
+	}
+	if provider.Name != "Dr. One" || provider.SlotDurationMinutes != 30 || provider.MinLeadTimeMinutes != 60 {
+		t.Fatalf("got %s with %d-minute slots and a %d-minute lead time, want Dr. One with 30 and 60",
+			provider.Name, provider.SlotDurationMinutes, provider.MinLeadTimeMinutes)
+	}
+
+	_, err = b.service.UpdateProvider(ctx, &pb.UpdateProviderRequest{Id: "p1", MinLeadTimeMinutes: proto.Int32(0), BufferAfterMinutes: proto.Int32(10)})
+	if err != nil {
+		t.Fatalf("UpdateProvider: %v", err)
+	}
+	provider, err = b.service.GetProvider(ctx, &pb.GetProviderRequest{Id: "p1"})
+	if err != nil {
+		t.Fatalf("GetProvider: %v", err)
+	}
+	if provider.Name != "Dr. One" || provider.SlotDurationMinutes != 30 || provider.BufferAfterMinutes != 10 || provider.MinLeadTimeMinutes != 0 {
+		t.Fatalf("got %+v, want Dr. One with 30-minute slots, a 10-minute buffer after and no lead time", provider)
+	}
+
+	for name, req := range map[string]*pb.UpdateProviderRequest{
+		"empty name":         {Id: "p1", Name: proto.String("")},
+		"zero slot duration": {Id: "p1", SlotDurationMinutes: proto.Int32(0)},
+		"negative buffer":    {Id: "p1", BufferBeforeMinutes: proto.Int32(-5)},
+		"unknown time zone":  {Id: "p1", TimeZone: proto.String("Mars/Olympus")},
+	} {
+		_, err := b.service.UpdateProvider(ctx, req)
+		if twerr, ok := err.(twirp.Error); !ok || twerr.Code() != twirp.InvalidArgument {
+			t.Errorf("UpdateProvider with %s: got %v, want invalid_argument", name, err)
+		}
+	}
+}
+
+func TestConfirmReservationNeedsHoldToken(t *testing.T) {
+	b := newTestBooking(t)
+	ctx := asClient("c1")
+	held := b.reserve(t, "c1", b.slots(t, "p1")[0])
+
+	_, err := b.service.ConfirmReservation(ctx, &pb.ConfirmReservationRequest{ReservationId: held.ReservationId, HoldToken: "wrong"})
+	wantCode(t, err, twirp.PermissionDenied)
+
+	_, err = b.service.ConfirmReservation(ctx, &pb.ConfirmReservationRequest{ReservationId: held.ReservationId, HoldToken: held.HoldToken})
+	if err != nil {
+		t.Fatalf("ConfirmReservation: %v", err)
+	}
+	reservation, err := b.repo.GetReservation(ctx, held.ReservationId)
+	if err != nil {
+		t.Fatalf("GetReservation: %v", err)
+	}
+	if reservation.Status != "Confirmed" {
+		t.Fatalf("got status %s, want Confirmed", reservation.Status)
+	}
+}
+
+func TestExtendHoldStoresUTCExpiry(t *testing.T) {
+	b := newTestBooking(t)
+	ctx := asClient("c1")
+	held := b.reserve(t, "c1", b.slots(t, "p1")[0])
+
+	resp, err := b.service.ExtendHold(ctx, &pb.ExtendHoldRequest{ReservationId: held.ReservationId, HoldToken: held.HoldToken})
+	if err != nil {
+		t.Fatalf("ExtendHold: %v", err)
+	}
+	reservation, err := b.repo.GetReservation(ctx, held.ReservationId)
+	if err != nil {
+		t.Fatalf("GetReservation: %v", err)
+	}
+	if reservation.ReservationExpiry.Location() != time.UTC {
+		t.Fatalf("stored expiry %v is not in UTC", reservation.ReservationExpiry)
+	}
+
+	firstExpiry, err := time.Parse(time.RFC3339, held.HoldExpiresAt)
+	if err != nil {
+		t.Fatalf("invalid hold_expires_at %q: %v", held.HoldExpiresAt, err)
+	}
+	wantExpiry := firstExpiry.Add(b.service.HoldExpiry).Format(time.RFC3339)
+	if resp.HoldExpiresAt != wantExpiry || resp.ExtensionsRemaining != 1 {
+		t.Fatalf("got expiry %s with %d extensions left, want %s with 1", resp.HoldExpiresAt, resp.ExtensionsRemaining, wantExpiry)
+	}
+}
+
+func TestRescheduleReservationKeepsProvider(t *testing.T) {
+	b := newTestBooking(t)
+	ctx := asClient("c1")
+	held := b.reserve(t, "c1", b.slots(t, "p1")[0])
+
+	_, err := b.service.RescheduleReservation(ctx, &pb.RescheduleReservationRequest{ReservationId: held.ReservationId, NewSlotId: b.slots(t, "p2")[0]})
+	wantCode(t, err, twirp.FailedPrecondition)
+
+	newSlotID := b.slots(t, "p1")[1]
+	_, err = b.service.RescheduleReservation(ctx, &pb.RescheduleReservationRequest{ReservationId: held.ReservationId, NewSlotId: newSlotID})
+	if err != nil {
+		t.Fatalf("RescheduleReservation: %v", err)
+	}
+	reservation, err := b.repo.GetReservation(ctx, held.ReservationId)
+	if err != nil {
+		t.Fatalf("GetReservation: %v", err)
+	}
+	if reservation.SlotID != newSlotID || reservation.ProviderID != "p1" {
+		t.Fatalf("reservation holds slot %s of %s, want slot %s of p1", reservation.SlotID, reservation.ProviderID, newSlotID)
+	}
+}
+
+func TestRescheduleReservationSwapsSlots(t *testing.T) {
+	b := newTestBooking(t)
+	ctx := asClient("c1")
+	slots := b.slots(t, "p1")
+	held := b.reserve(t, "c1", slots[0])
+	taken := b.reserve(t, "c2", slots[1])
+
+	// A slot someone else holds cannot be taken
+	_, err := b.service.RescheduleReservation(ctx, &pb.RescheduleReservationRequest{ReservationId: held.ReservationId, NewSlotId: slots[1]})
+	wantCode(t, err, twirp.FailedPrecondition)
+
+	_, err = b.service.RescheduleReservation(ctx, &pb.RescheduleReservationRequest{ReservationId: held.ReservationId, NewSlotId: slots[2]})
+	if err != nil {
+		t.Fatalf("RescheduleReservation: %v", err)
+	}
+	available := b.slots(t, "p1")
+	if !contains(available, slots[0]) || contains(available, slots[2]) {
+		t.Fatalf("available slots %v, want the old slot %s back and the new slot %s taken", available, slots[0], slots[2])
+	}
+
+	// Someone else's reservation cannot be moved
+	_, err = b.service.RescheduleReservation(ctx, &pb.RescheduleReservationRequest{ReservationId: taken.ReservationId, NewSlotId: slots[3]})
+	wantCode(t, err, twirp.PermissionDenied)
+}
+
+func TestRescheduleReservationRejectsExpiredHold(t *testing.T) {
+	b := newTestBooking(t)
+	b.service.HoldExpiry = -time.Minute
+	slots := b.slots(t, "p1")
+	held := b.reserve(t, "c1", slots[0])
+
+	_, err := b.service.RescheduleReservation(asClient("c1"), &pb.RescheduleReservationRequest{ReservationId: held.ReservationId, NewSlotId: slots[1]})
+	wantCode(t, err, twirp.FailedPrecondition)
+	if !contains(b.slots(t, "p1"), slots[1]) {
+		t.Fatalf("slot %s was taken by an expired hold", slots[1])
+	}
+}
+
+func TestCancelReservationRecordsCaller(t *testing.T) {
+	b := newTestBooking(t)
+	slots := b.slots(t, "p1")
+	first := b.reserve(t, "c1", slots[0])
+	second := b.reserve(t, "c1", slots[1])
+
+	_, err := b.service.CancelReservation(asClient("c1"), &pb.CancelReservationRequest{ReservationId: first.ReservationId})
+	if err != nil {
+		t.Fatalf("CancelReservation as client: %v", err)
+	}
+	_, err = b.service.CancelReservation(asAdmin(), &pb.CancelReservationRequest{ReservationId: second.ReservationId})
+	if err != nil {
+		t.Fatalf("CancelReservation as admin: %v", err)
+	}
+
+	var cancelledBy []string
+	for _, cancellation := range b.repo.cancellations {
+		cancelledBy = append(cancelledBy, cancellation.CancelledBy)
+	}
+	if len(cancelledBy) != 2 || cancelledBy[0] != "c1" || cancelledBy[1] != "admin" {
+		t.Fatalf("got cancellations by %v, want [c1 admin]", cancelledBy)
+	}
+}
+
+func TestCancelReservationOffersSlotToWaitlist(t *testing.T) {
+	b := newTestBooking(t)
+
+	// c1 books every slot, so c2 joins the waitlist for the day
+	var held []*pb.ReserveSlotResponse
+	for _, slotID := range b.slots(t, "p1") {
+		held = append(held, b.reserve(t, "c1", slotID))
+	}
+	joined, err := b.service.JoinWaitlist(asClient("c2"), &pb.JoinWaitlistRequest{ClientId: "c2", ProviderId: "p1", StartDate: b.day, EndDate: b.day})
+	if err != nil {
+		t.Fatalf("JoinWaitlist: %v", err)
+	}
+
+	_, err = b.service.CancelReservation(asClient("c1"), &pb.CancelReservationRequest{ReservationId: held[0].ReservationId})
+	if err != nil {
+		t.Fatalf("CancelReservation: %v", err)
+	}
+
+	waitlist, err := b.service.GetWaitlistByClient(asClient("c2"), &pb.GetWaitlistByClientRequest{ClientId: "c2"})
+	if err != nil {
+		t.Fatalf("GetWaitlistByClient: %v", err)
+	}
+	if len(waitlist.Entries) != 1 {
+		t.Fatalf("got %d waitlist entries, want 1", len(waitlist.Entries))
+	}
+	entry := waitlist.Entries[0]
+	if entry.Id != joined.Id || entry.Status != models.WaitlistOffered || entry.ReservationId == "" {
+		t.Fatalf("got entry %s in status %s with reservation %q, want an offer for %s", entry.Id, entry.Status, entry.ReservationId, joined.Id)
+	}
+
+	// The offer is a hold for c2 on the freed slot
+	offer, err := b.repo.GetReservation(asAdmin(), entry.ReservationId)
+	if err != nil {
+		t.Fatalf("GetReservation: %v", err)
+	}
+	if offer.ClientID != "c2" || offer.Status != "Reserved" {
+		t.Fatalf("got offer held by %s in status %s, want a hold for c2", offer.ClientID, offer.Status)
+	}
+}
+
+func TestCancelReservationReturnsSlotToPool(t *testing.T) {
+	b := newTestBooking(t)
+	ctx := asClient("c1")
+	slotID := b.slots(t, "p1")[0]
+	held := b.reserve(t, "c1", slotID)
+	if contains(b.slots(t, "p1"), slotID) {
+		t.Fatalf("reserved slot %s is still available", slotID)
+	}
+
+	_, err := b.service.CancelReservation(ctx, &pb.CancelReservationRequest{ReservationId: held.ReservationId, Reason: "sick"})
+	if err != nil {
+		t.Fatalf("CancelReservation: %v", err)
+	}
+	if !contains(b.slots(t, "p1"), slotID) {
+		t.Fatalf("cancelled slot %s is not available again", slotID)
+	}
+	if _, err := b.repo.GetReservation(ctx, held.ReservationId); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("GetReservation of a cancelled reservation: got %v, want ErrNotFound", err)
+	}
+	if len(b.repo.cancellations) != 1 || b.repo.cancellations[0].Reason != "sick" {
+		t.Fatalf("got cancellations %+v, want one with reason sick", b.repo.cancellations)
+	}
+
+	_, err = b.service.CancelReservation(ctx, &pb.CancelReservationRequest{ReservationId: held.ReservationId})
+	wantCode(t, err, twirp.NotFound)
+}
+
+func TestCancelReservationChecksCallerAndCutoff(t *testing.T) {
+	b := newTestBooking(t)
+	held := b.reserve(t, "c1", b.slots(t, "p1")[0])
+	req := &pb.CancelReservationRequest{ReservationId: held.ReservationId}
+
+	// Only the client, the provider and admins may cancel
+	_, err := b.service.CancelReservation(asClient("c2"), req)
+	wantCode(t, err, twirp.PermissionDenied)
+	_, err = b.service.CancelReservation(asProvider("p2"), req)
+	wantCode(t, err, twirp.PermissionDenied)
+
+	// The reservation starts in three days, inside a four-day cutoff
+	b.service.CancellationCutoff = 96 * time.Hour
+	_, err = b.service.CancelReservation(asClient("c1"), req)
+	wantCode(t, err, twirp.FailedPrecondition)
+
+	b.service.CancellationCutoff = time.Hour
+	_, err = b.service.CancelReservation(asProvider("p1"), req)
+	if err != nil {
+		t.Fatalf("CancelReservation as provider: %v", err)
+	}
+}
+
+func contains(ids []string, id string) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
+
+func TestRolesLimitCallersToTheirOwnRecords(t *testing.T) {
+	b := newTestBooking(t)
+	held := b.reserve(t, "c1", b.slots(t, "p1")[0])
+	window := []*pb.TimeSlot{{StartTime: b.day + "T12:00:00Z", EndTime: b.day + "T13:00:00Z"}}
+
+	tests := []struct {
+		name string
+		call func() error
+		want twirp.ErrorCode
+	}{
+		{"unauthenticated read", func() error {
+			_, err := b.service.GetAvailableSlots(context.Background(), &pb.GetAvailableSlotsRequest{ProviderId: "p1", Date: b.day})
+			return err
+		}, twirp.Unauthenticated},
+		{"client creating a provider", func() error {
+			_, err := b.service.CreateProvider(asClient("c1"), &pb.CreateProviderRequest{Id: "p3", Name: "p3"})
+			return err
+		}, twirp.PermissionDenied},
+		{"provider deactivating itself", func() error {
+			_, err := b.service.DeactivateProvider(asProvider("p1"), &pb.DeactivateProviderRequest{Id: "p1"})
+			return err
+		}, twirp.PermissionDenied},
+		{"provider setting another's availability", func() error {
+			_, err := b.service.SetAvailability(asProvider("p2"), &pb.SetAvailabilityRequest{ProviderId: "p1", TimeSlots: window})
+			return err
+		}, twirp.PermissionDenied},
+		{"provider listing another's reservations", func() error {
+			_, err := b.service.GetReservedSlotsByProvider(asProvider("p2"), &pb.GetReservedSlotsByProviderRequest{ProviderId: "p1"})
+			return err
+		}, twirp.PermissionDenied},
+		{"client reading another client", func() error {
+			_, err := b.service.GetClient(asClient("c2"), &pb.GetClientRequest{Id: "c1"})
+			return err
+		}, twirp.PermissionDenied},
+		{"client booking for another client", func() error {
+			_, err := b.service.ReserveSlot(asClient("c2"), &pb.ReserveSlotRequest{SlotId: b.slots(t, "p1")[0], ClientId: "c1"})
+			return err
+		}, twirp.PermissionDenied},
+		{"client listing another's reservations", func() error {
+			_, err := b.service.GetReservedSlotsByClient(asClient("c2"), &pb.GetReservedSlotsByClientRequest{ClientId: "c1"})
+			return err
+		}, twirp.PermissionDenied},
+		{"provider rescheduling another's reservation", func() error {
+			_, err := b.service.RescheduleReservation(asProvider("p2"), &pb.RescheduleReservationRequest{ReservationId: held.ReservationId, NewSlotId: b.slots(t, "p1")[0]})
+			return err
+		}, twirp.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wantCode(t, tt.call(), tt.want)
+		})
+	}
+
+	// Each role may act on its own records, and admins on any
+	if _, err := b.service.SetAvailability(asProvider("p1"), &pb.SetAvailabilityRequest{ProviderId: "p1", TimeSlots: window}); err != nil {
+		t.Fatalf("SetAvailability as the provider: %v", err)
+	}
+	if _, err := b.service.GetReservedSlotsByClient(asClient("c1"), &pb.GetReservedSlotsByClientRequest{ClientId: "c1"}); err != nil {
+		t.Fatalf("GetReservedSlotsByClient as the client: %v", err)
+	}
+	if _, err := b.service.GetReservedSlotsByProvider(asProvider("p1"), &pb.GetReservedSlotsByProviderRequest{ProviderId: "p1"}); err != nil {
+		t.Fatalf("GetReservedSlotsByProvider as the provider: %v", err)
+	}
+	if _, err := b.service.DeactivateProvider(asAdmin(), &pb.DeactivateProviderRequest{Id: "p2"}); err != nil {
+		t.Fatalf("DeactivateProvider as admin: %v", err)
+	}
+}
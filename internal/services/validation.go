@@ -0,0 +1,52 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/manueldelreal/health-reservation-system/api"
+)
+
+// maxWindowLength is the longest availability window SetAvailability accepts.
+const maxWindowLength = 24 * time.Hour
+
+// stepMessage explains that a window must hold a whole number of a provider's
+// slots and buffers.
+func stepMessage(settings slotSettings) string {
+	return fmt.Sprintf("window length must be a multiple of the %s taken by each slot and its buffers", formatDuration(settings.step()))
+}
+
+// validateTimeSlot checks a SetAvailability time slot and returns its span in
+// UTC. The window must lie in the future, be at most maxWindowLength long and
+// hold a whole number of the provider's slots and buffers. The index of a
+// returned error is left for the caller to set.
+func validateTimeSlot(timeSlot *pb.TimeSlot, settings slotSettings, now time.Time) (interval, *pb.TimeSlotError) {
+	startTime, err := time.Parse(time.RFC3339, timeSlot.StartTime)
+	if err != nil {
+		return interval{}, &pb.TimeSlotError{Argument: "start_time", Message: "invalid start time format"}
+	}
+	endTime, err := time.Parse(time.RFC3339, timeSlot.EndTime)
+	if err != nil {
+		return interval{}, &pb.TimeSlotError{Argument: "end_time", Message: "invalid end time format"}
+	}
+
+	if !endTime.After(startTime) {
+		return interval{}, &pb.TimeSlotError{Argument: "end_time", Message: "end time must be after start time"}
+	}
+	if startTime.Before(now) {
+		return interval{}, &pb.TimeSlotError{Argument: "start_time", Message: "start time must not be in the past"}
+	}
+	length := endTime.Sub(startTime)
+	if length > maxWindowLength {
+		return interval{}, &pb.TimeSlotError{
+			Argument: "end_time",
+			Message:  fmt.Sprintf("windows must not be longer than %s", formatDuration(maxWindowLength)),
+		}
+	}
+	if length%settings.step() != 0 {
+		return interval{}, &pb.TimeSlotError{Argument: "end_time", Message: stepMessage(settings)}
+	}
+
+	// Times are stored in UTC
+	return interval{startTime.UTC(), endTime.UTC()}, nil
+}
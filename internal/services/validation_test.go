@@ -0,0 +1,85 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/manueldelreal/health-reservation-system/api"
+)
+
+func TestValidateTimeSlot(t *testing.T) {
+	now := time.Date(2030, 1, 1, 8, 0, 0, 0, time.UTC)
+	settings := slotSettings{duration: 30 * time.Minute, bufferAfter: 10 * time.Minute}
+
+	tests := []struct {
+		name       string
+		start, end string
+		argument   string
+	}{
+		{"valid", "2030-01-01T09:00:00Z", "2030-01-01T11:00:00Z", ""},
+		{"valid with offset", "2030-01-01T10:00:00+01:00", "2030-01-01T10:40:00+01:00", ""},
+		{"bad start", "09:00", "2030-01-01T11:00:00Z", "start_time"},
+		{"bad end", "2030-01-01T09:00:00Z", "2030-01-01", "end_time"},
+		{"end before start", "2030-01-01T11:00:00Z", "2030-01-01T09:00:00Z", "end_time"},
+		{"empty", "2030-01-01T09:00:00Z", "2030-01-01T09:00:00Z", "end_time"},
+		{"in the past", "2030-01-01T07:00:00Z", "2030-01-01T09:00:00Z", "start_time"},
+		{"too long", "2030-01-01T09:00:00Z", "2030-01-02T09:40:00Z", "end_time"},
+		{"not whole slots", "2030-01-01T09:00:00Z", "2030-01-01T10:00:00Z", "end_time"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			span, timeSlotErr := validateTimeSlot(&pb.TimeSlot{StartTime: tt.start, EndTime: tt.end}, settings, now)
+			if tt.argument == "" {
+				if timeSlotErr != nil {
+					t.Fatalf("got error %q, want none", timeSlotErr.Message)
+				}
+				if span.start.Location() != time.UTC || span.end.Location() != time.UTC {
+					t.Fatalf("got span %v to %v, want it in UTC", span.start, span.end)
+				}
+				return
+			}
+			if timeSlotErr == nil || timeSlotErr.Argument != tt.argument {
+				t.Fatalf("got error %v, want one for %s", timeSlotErr, tt.argument)
+			}
+		})
+	}
+}
+
+func TestSetAvailabilityPartialAccept(t *testing.T) {
+	b := newTestBooking(t)
+	req := &pb.SetAvailabilityRequest{
+		ProviderId: "p1",
+		TimeSlots: []*pb.TimeSlot{
+			{StartTime: b.day + "T12:00:00Z", EndTime: b.day + "T13:00:00Z"},
+			{StartTime: b.day + "T14:00:00Z", EndTime: b.day + "T14:45:00Z"},
+		},
+	}
+
+	// Without partial accept the invalid time slot saves nothing
+	resp, err := b.service.SetAvailability(asProvider("p1"), req)
+	if err != nil {
+		t.Fatalf("SetAvailability: %v", err)
+	}
+	if len(resp.Errors) != 1 || resp.Errors[0].Index != 1 || resp.Errors[0].Argument != "end_time" {
+		t.Fatalf("got errors %v, want one for the end of time slot 1", resp.Errors)
+	}
+	if resp.Results[0].Outcome != outcomeSkipped || resp.Results[1].Outcome != outcomeInvalid {
+		t.Fatalf("got outcomes %s and %s, want skipped and invalid", resp.Results[0].Outcome, resp.Results[1].Outcome)
+	}
+	if got := len(b.slots(t, "p1")); got != 4 {
+		t.Fatalf("got %d slots, want the 4 set before", got)
+	}
+
+	// With it the valid one is saved
+	req.PartialAccept = true
+	resp, err = b.service.SetAvailability(asProvider("p1"), req)
+	if err != nil {
+		t.Fatalf("SetAvailability with partial accept: %v", err)
+	}
+	if len(resp.Errors) != 1 || resp.Results[0].Outcome != outcomeCreated || resp.Results[1].Outcome != outcomeInvalid {
+		t.Fatalf("got outcomes %s and %s and errors %v, want created and invalid", resp.Results[0].Outcome, resp.Results[1].Outcome, resp.Errors)
+	}
+	if got := len(b.slots(t, "p1")); got != 6 {
+		t.Fatalf("got %d slots, want 6", got)
+	}
+}
@@ -0,0 +1,363 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	pb "github.com/manueldelreal/health-reservation-system/api"
+	"github.com/manueldelreal/health-reservation-system/internal/auth"
+	"github.com/manueldelreal/health-reservation-system/internal/models"
+	"github.com/manueldelreal/health-reservation-system/internal/storage"
+)
+
+// DefaultWaitlistOfferExpiry is how long a waitlist offer is held for the
+// client when the service does not set its own.
+const DefaultWaitlistOfferExpiry = time.Hour
+
+func (s *ReservationService) JoinWaitlist(ctx context.Context, req *pb.JoinWaitlistRequest) (*pb.JoinWaitlistResponse, error) {
+	if err := authorize(ctx, auth.Client(req.ClientId)); err != nil {
+		return nil, err
+	}
+
+	// Only registered clients may wait for an active provider
+	if _, err := s.Repo.GetClient(ctx, req.ClientId); err != nil {
+		return nil, lookupError(err, "client", req.ClientId)
+	}
+	provider, err := s.Repo.GetProvider(ctx, req.ProviderId)
+	if err != nil {
+		return nil, lookupError(err, "provider", req.ProviderId)
+	}
+	if !provider.Active {
+		return nil, failedPrecondition("provider is not active").WithMeta("provider_id", provider.ID)
+	}
+
+	// Validate the date range, starting today in the provider's time zone if
+	// no start date is given
+	loc, err := loadLocation(provider.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	today := time.Now().In(loc).Format("2006-01-02")
+	startDate := req.StartDate
+	if startDate == "" {
+		startDate = today
+	}
+	if _, err := time.Parse("2006-01-02", startDate); err != nil {
+		return nil, invalidArgument("start_date", "invalid start date format")
+	}
+	if req.EndDate == "" {
+		return nil, invalidArgument("end_date", "end_date is required")
+	}
+	if _, err := time.Parse("2006-01-02", req.EndDate); err != nil {
+		return nil, invalidArgument("end_date", "invalid end date format")
+	}
+	if req.EndDate < startDate {
+		return nil, invalidArgument("end_date", "end date must not be before start date")
+	}
+	if req.EndDate < today {
+		return nil, invalidArgument("end_date", "end date must not be in the past")
+	}
+
+	// Validate the preferences
+	if req.AppointmentTypeId != "" {
+		if _, err := s.Repo.GetAppointmentType(ctx, req.AppointmentTypeId, req.ProviderId); err != nil {
+			return nil, lookupError(err, "appointment type", req.AppointmentTypeId)
+		}
+	}
+	var weekdays []string
+	for _, name := range req.Weekdays {
+		weekday, err := parseWeekday(name)
+		if err != nil {
+			return nil, err
+		}
+		weekdays = append(weekdays, weekday.String())
+	}
+	earliestTime, err := parseClock(req.EarliestTime, "earliest_time")
+	if err != nil {
+		return nil, err
+	}
+	latestTime, err := parseClock(req.LatestTime, "latest_time")
+	if err != nil {
+		return nil, err
+	}
+	if earliestTime != "" && latestTime != "" && latestTime < earliestTime {
+		return nil, invalidArgument("latest_time", "latest time must not be before earliest time")
+	}
+
+	entry := models.WaitlistEntry{
+		ID:                generateID(),
+		ClientID:          req.ClientId,
+		ProviderID:        req.ProviderId,
+		AppointmentTypeID: req.AppointmentTypeId,
+		StartDate:         startDate,
+		EndDate:           req.EndDate,
+		Weekdays:          strings.Join(weekdays, ","),
+		EarliestTime:      earliestTime,
+		LatestTime:        latestTime,
+		Status:            models.WaitlistWaiting,
+		CreatedAt:         time.Now().UTC(),
+	}
+	err = s.Repo.CreateWaitlistEntry(ctx, entry)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	return &pb.JoinWaitlistResponse{
+		Id:      entry.ID,
+		Message: "Joined the waitlist",
+	}, nil
+}
+
+func (s *ReservationService) GetWaitlistByClient(ctx context.Context, req *pb.GetWaitlistByClientRequest) (*pb.GetWaitlistByClientResponse, error) {
+	if err := authorize(ctx, auth.Client(req.ClientId)); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.Repo.GetWaitlistEntriesByClient(ctx, req.ClientId)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	// Convert database results to protobuf response
+	var pbEntries []*pb.WaitlistEntry
+	for _, entry := range entries {
+		pbEntry := &pb.WaitlistEntry{
+			Id:                entry.ID,
+			ClientId:          entry.ClientID,
+			ProviderId:        entry.ProviderID,
+			StartDate:         entry.StartDate,
+			EndDate:           entry.EndDate,
+			AppointmentTypeId: entry.AppointmentTypeID,
+			EarliestTime:      entry.EarliestTime,
+			LatestTime:        entry.LatestTime,
+			Status:            entry.Status,
+			ReservationId:     entry.ReservationID,
+		}
+		if entry.Weekdays != "" {
+			pbEntry.Weekdays = strings.Split(entry.Weekdays, ",")
+		}
+
+		// Show the time and expiry of an open offer
+		if entry.Status == models.WaitlistOffered {
+			reservation, err := s.Repo.GetReservation(ctx, entry.ReservationID)
+			if err != nil && !errors.Is(err, storage.ErrNotFound) {
+				return nil, storageError(err)
+			}
+			if err == nil {
+				pbEntry.Offer = &pb.TimeSlot{
+					Id:        reservation.SlotID,
+					StartTime: reservation.StartTime.Format(time.RFC3339),
+					EndTime:   reservation.EndTime.Format(time.RFC3339),
+					Status:    reservation.Status,
+				}
+				if reservation.ReservationExpiry != nil {
+					pbEntry.OfferExpiresAt = reservation.ReservationExpiry.UTC().Format(time.RFC3339)
+				}
+			}
+		}
+		pbEntries = append(pbEntries, pbEntry)
+	}
+
+	return &pb.GetWaitlistByClientResponse{Entries: pbEntries}, nil
+}
+
+func (s *ReservationService) AcceptWaitlistOffer(ctx context.Context, req *pb.AcceptWaitlistOfferRequest) (*pb.AcceptWaitlistOfferResponse, error) {
+	if err := authenticated(ctx); err != nil {
+		return nil, err
+	}
+
+	// Only the waiting client may accept the offer
+	entry, err := s.Repo.GetWaitlistEntry(ctx, req.Id)
+	if err != nil {
+		return nil, lookupError(err, "waitlist entry", req.Id)
+	}
+	if err := authorize(ctx, auth.Client(entry.ClientID)); err != nil {
+		return nil, err
+	}
+
+	// Confirm the offered reservation, unless the offer has lapsed
+	err = s.Repo.AcceptWaitlistOffer(ctx, entry.ID)
+	if err != nil {
+		return nil, storageError(err)
+	}
+	s.Metrics.ReservationConfirmed()
+
+	return &pb.AcceptWaitlistOfferResponse{
+		ReservationId: entry.ReservationID,
+		Message:       "Waitlist offer accepted, reservation confirmed",
+	}, nil
+}
+
+// offerFreedSlots offers the slots a released reservation held to the
+// provider's waitlist. Each freed slot goes to the oldest waiting entry it
+// suits, as a hold only that entry's client can confirm, through
+// AcceptWaitlistOffer. Failures are logged, as the release has already
+// succeeded.
+func (s *ReservationService) offerFreedSlots(ctx context.Context, released models.Reservation) {
+	entries, err := s.Repo.GetWaitingEntries(ctx, released.ProviderID)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to fetch waitlist", "provider_id", released.ProviderID, "error", err)
+		return
+	}
+	if len(entries) == 0 {
+		return
+	}
+
+	// Inactive providers cannot be booked, and slots inside the lead time
+	// cannot be offered
+	provider, err := s.Repo.GetProvider(ctx, released.ProviderID)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to fetch provider for waitlist", "provider_id", released.ProviderID, "error", err)
+		return
+	}
+	if !provider.Active {
+		return
+	}
+	loc, err := loadLocation(provider.TimeZone)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to load provider time zone for waitlist", "provider_id", provider.ID, "error", err)
+		return
+	}
+	earliestStart := time.Now().Add(s.providerSettings(provider).minLeadTime)
+
+	// The freed slots are back in the pool, unless someone has taken them
+	// since. A reservation can run past midnight, so fetch every day it covers
+	var slots []models.Slot
+	for day := released.StartTime.In(loc); day.Before(released.EndTime); day = nextDay(day) {
+		daySlots, err := s.Repo.GetAvailableSlots(ctx, provider.ID, day)
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to fetch freed slots for waitlist", "provider_id", provider.ID, "error", err)
+			return
+		}
+		slots = append(slots, daySlots...)
+	}
+
+	// Entries that got an offer, or were found no longer waiting
+	done := make(map[string]bool)
+	for _, slot := range slots {
+		if slot.StartTime.Before(released.StartTime) || !slot.StartTime.Before(released.EndTime) || slot.StartTime.Before(earliestStart) {
+			continue
+		}
+
+	entries:
+		for _, entry := range entries {
+			if done[entry.ID] || !waitlistMatches(entry, slot.StartTime.In(loc)) {
+				continue
+			}
+			count, maxGap, err := s.bookingShape(ctx, provider.ID, entry.AppointmentTypeID)
+			if err != nil {
+				continue
+			}
+
+			// Hold the slots for the entry's client until the offer expires
+			expiration := time.Now().UTC().Add(s.waitlistOfferExpiry())
+			reservation := models.Reservation{
+				ID:                generateID(),
+				ClientID:          entry.ClientID,
+				AppointmentTypeID: entry.AppointmentTypeID,
+				ReservationExpiry: &expiration,
+				Status:            "Reserved",
+			}
+			err = s.Repo.OfferWaitlistSlot(ctx, entry.ID, reservation, slot.ID, count, maxGap)
+			switch {
+			case err == nil:
+				done[entry.ID] = true
+				s.Metrics.WaitlistOffered()
+				slog.InfoContext(ctx, "Offered freed slot to waitlist",
+					"waitlist_entry_id", entry.ID,
+					"client_id", entry.ClientID,
+					"provider_id", provider.ID,
+					"reservation_id", reservation.ID,
+					"slot_id", slot.ID,
+					"expires_at", expiration,
+				)
+				break entries
+			case errors.Is(err, storage.ErrNotWaiting):
+				done[entry.ID] = true
+			case errors.Is(err, storage.ErrNotEnoughSlots):
+				// A shorter appointment further down the list may still fit
+			case errors.Is(err, storage.ErrSlotUnavailable):
+				break entries
+			default:
+				slog.WarnContext(ctx, "Failed to offer freed slot to waitlist", "waitlist_entry_id", entry.ID, "slot_id", slot.ID, "error", err)
+				return
+			}
+		}
+	}
+}
+
+// expireStaleWaitlistEntries expires the waiting entries whose last day has
+// passed in their provider's time zone, as no slot can suit them any more.
+func (s *ReservationService) expireStaleWaitlistEntries(ctx context.Context) error {
+	providers, err := s.Repo.ListProviders(ctx, true)
+	if err != nil {
+		return err
+	}
+	for _, provider := range providers {
+		loc, err := loadLocation(provider.TimeZone)
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to load provider time zone for waitlist", "provider_id", provider.ID, "error", err)
+			continue
+		}
+		today := time.Now().In(loc).Format("2006-01-02")
+		expired, err := s.Repo.ExpireWaitlistEntries(ctx, provider.ID, today)
+		if err != nil {
+			return err
+		}
+		if expired > 0 {
+			slog.InfoContext(ctx, "Expired stale waitlist entries", "provider_id", provider.ID, "count", expired)
+		}
+	}
+	return nil
+}
+
+// nextDay returns the midnight that starts the day after t, in t's location.
+func nextDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+}
+
+// waitlistOfferExpiry returns how long a waitlist offer is held for the client.
+func (s *ReservationService) waitlistOfferExpiry() time.Duration {
+	if s.WaitlistOfferExpiry > 0 {
+		return s.WaitlistOfferExpiry
+	}
+	return DefaultWaitlistOfferExpiry
+}
+
+// waitlistMatches reports whether a slot starting at start, given in the
+// provider's time zone, suits the dates, weekdays and times of a waitlist
+// entry.
+func waitlistMatches(entry models.WaitlistEntry, start time.Time) bool {
+	date := start.Format("2006-01-02")
+	if date < entry.StartDate || date > entry.EndDate {
+		return false
+	}
+	if entry.Weekdays != "" && !slices.Contains(strings.Split(entry.Weekdays, ","), start.Weekday().String()) {
+		return false
+	}
+	clock := start.Format("15:04")
+	if entry.EarliestTime != "" && clock < entry.EarliestTime {
+		return false
+	}
+	if entry.LatestTime != "" && clock > entry.LatestTime {
+		return false
+	}
+	return true
+}
+
+// parseClock validates an optional "HH:MM" time of day of the named argument
+// and returns it zero-padded, so that times compare as strings.
+func parseClock(value, argument string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	clock, err := time.Parse("15:04", value)
+	if err != nil {
+		return "", invalidArgument(argument, "invalid "+strings.ReplaceAll(argument, "_", " ")+" format")
+	}
+	return clock.Format("15:04"), nil
+}
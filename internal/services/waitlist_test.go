@@ -0,0 +1,212 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/twitchtv/twirp"
+
+	pb "github.com/manueldelreal/health-reservation-system/api"
+	"github.com/manueldelreal/health-reservation-system/internal/models"
+)
+
+// joinWaitlist adds a client to p1's waitlist for the test day and returns the
+// entry ID.
+func (b *testBooking) joinWaitlist(t *testing.T, req *pb.JoinWaitlistRequest) string {
+	t.Helper()
+	req.ProviderId = "p1"
+	req.StartDate = b.day
+	req.EndDate = b.day
+	resp, err := b.service.JoinWaitlist(asClient(req.ClientId), req)
+	if err != nil {
+		t.Fatalf("JoinWaitlist: %v", err)
+	}
+	return resp.Id
+}
+
+// waitlistEntry returns a client's waitlist entry.
+func (b *testBooking) waitlistEntry(t *testing.T, clientID, entryID string) *pb.WaitlistEntry {
+	t.Helper()
+	resp, err := b.service.GetWaitlistByClient(asClient(clientID), &pb.GetWaitlistByClientRequest{ClientId: clientID})
+	if err != nil {
+		t.Fatalf("GetWaitlistByClient: %v", err)
+	}
+	for _, entry := range resp.Entries {
+		if entry.Id == entryID {
+			return entry
+		}
+	}
+	t.Fatalf("client %s has no waitlist entry %s", clientID, entryID)
+	return nil
+}
+
+func TestJoinWaitlistValidatesRequest(t *testing.T) {
+	b := newTestBooking(t)
+	yesterday := time.Now().UTC().AddDate(0, 0, -1).Format(time.DateOnly)
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		req  *pb.JoinWaitlistRequest
+		code twirp.ErrorCode
+	}{
+		{"another client", asClient("c2"), &pb.JoinWaitlistRequest{ClientId: "c1", ProviderId: "p1", EndDate: b.day}, twirp.PermissionDenied},
+		{"unknown provider", asClient("c1"), &pb.JoinWaitlistRequest{ClientId: "c1", ProviderId: "missing", EndDate: b.day}, twirp.NotFound},
+		{"no end date", asClient("c1"), &pb.JoinWaitlistRequest{ClientId: "c1", ProviderId: "p1"}, twirp.InvalidArgument},
+		{"end before start", asClient("c1"), &pb.JoinWaitlistRequest{ClientId: "c1", ProviderId: "p1", StartDate: b.day, EndDate: yesterday}, twirp.InvalidArgument},
+		{"end in the past", asClient("c1"), &pb.JoinWaitlistRequest{ClientId: "c1", ProviderId: "p1", StartDate: yesterday, EndDate: yesterday}, twirp.InvalidArgument},
+		{"unknown weekday", asClient("c1"), &pb.JoinWaitlistRequest{ClientId: "c1", ProviderId: "p1", EndDate: b.day, Weekdays: []string{"Funday"}}, twirp.InvalidArgument},
+		{"latest before earliest", asClient("c1"), &pb.JoinWaitlistRequest{ClientId: "c1", ProviderId: "p1", EndDate: b.day, EarliestTime: "10:00", LatestTime: "9:30"}, twirp.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := b.service.JoinWaitlist(tt.ctx, tt.req)
+			wantCode(t, err, tt.code)
+		})
+	}
+}
+
+func TestCancellationOffersSlotToWaitlist(t *testing.T) {
+	b := newTestBooking(t)
+	slots := b.slots(t, "p1")
+	var held []*pb.ReserveSlotResponse
+	for _, slotID := range slots {
+		held = append(held, b.reserve(t, "c1", slotID))
+	}
+	entryID := b.joinWaitlist(t, &pb.JoinWaitlistRequest{ClientId: "c2", EarliestTime: "10:00"})
+
+	// The 09:00 slot is earlier than c2 wants, so it is not offered
+	cancel := func(reservationID string) {
+		t.Helper()
+		if _, err := b.service.CancelReservation(asClient("c1"), &pb.CancelReservationRequest{ReservationId: reservationID}); err != nil {
+			t.Fatalf("CancelReservation: %v", err)
+		}
+	}
+	cancel(held[0].ReservationId)
+	if entry := b.waitlistEntry(t, "c2", entryID); entry.Status != models.WaitlistWaiting {
+		t.Fatalf("got status %s after the 09:00 slot was freed, want Waiting", entry.Status)
+	}
+
+	// The 10:00 slot is held for c2, and only c2 may accept it
+	cancel(held[2].ReservationId)
+	entry := b.waitlistEntry(t, "c2", entryID)
+	if entry.Status != models.WaitlistOffered || entry.Offer.GetId() != slots[2] || entry.OfferExpiresAt == "" {
+		t.Fatalf("got entry %v, want an open offer of slot %s", entry, slots[2])
+	}
+	if contains(b.slots(t, "p1"), slots[2]) {
+		t.Fatalf("offered slot %s is still available", slots[2])
+	}
+	_, err := b.service.AcceptWaitlistOffer(asClient("c1"), &pb.AcceptWaitlistOfferRequest{Id: entryID})
+	wantCode(t, err, twirp.PermissionDenied)
+
+	resp, err := b.service.AcceptWaitlistOffer(asClient("c2"), &pb.AcceptWaitlistOfferRequest{Id: entryID})
+	if err != nil {
+		t.Fatalf("AcceptWaitlistOffer: %v", err)
+	}
+	reservation, err := b.repo.GetReservation(context.Background(), resp.ReservationId)
+	if err != nil {
+		t.Fatalf("GetReservation: %v", err)
+	}
+	if reservation.ClientID != "c2" || reservation.Status != "Confirmed" {
+		t.Fatalf("got reservation of %s with status %s, want c2's confirmed", reservation.ClientID, reservation.Status)
+	}
+	if entry := b.waitlistEntry(t, "c2", entryID); entry.Status != models.WaitlistAccepted {
+		t.Fatalf("got status %s after accepting, want Accepted", entry.Status)
+	}
+	_, err = b.service.AcceptWaitlistOffer(asClient("c2"), &pb.AcceptWaitlistOfferRequest{Id: entryID})
+	wantCode(t, err, twirp.FailedPrecondition)
+}
+
+func TestCancellingOfferDeclinesIt(t *testing.T) {
+	b := newTestBooking(t)
+	slots := b.slots(t, "p1")
+	held := b.reserve(t, "c1", slots[0])
+	entryID := b.joinWaitlist(t, &pb.JoinWaitlistRequest{ClientId: "c2", LatestTime: "09:00"})
+	if _, err := b.service.CancelReservation(asClient("c1"), &pb.CancelReservationRequest{ReservationId: held.ReservationId}); err != nil {
+		t.Fatalf("CancelReservation: %v", err)
+	}
+	entry := b.waitlistEntry(t, "c2", entryID)
+	if entry.Status != models.WaitlistOffered {
+		t.Fatalf("got status %s, want Offered", entry.Status)
+	}
+
+	// Cancelling the offered reservation declines the offer and frees the slot
+	if _, err := b.service.CancelReservation(asClient("c2"), &pb.CancelReservationRequest{ReservationId: entry.ReservationId}); err != nil {
+		t.Fatalf("CancelReservation of the offer: %v", err)
+	}
+	if entry := b.waitlistEntry(t, "c2", entryID); entry.Status != models.WaitlistDeclined {
+		t.Fatalf("got status %s after cancelling the offer, want Declined", entry.Status)
+	}
+	if !contains(b.slots(t, "p1"), slots[0]) {
+		t.Fatalf("slot %s was not freed with the declined offer", slots[0])
+	}
+}
+
+func TestCleanupExpiredReservationsOffersSlotToWaitlist(t *testing.T) {
+	b := newTestBooking(t)
+	ctx := context.Background()
+	b.service.HoldExpiry = -time.Minute
+	b.service.WaitlistOfferExpiry = time.Nanosecond
+	slotID := b.slots(t, "p1")[0]
+	b.reserve(t, "c1", slotID)
+	first := b.joinWaitlist(t, &pb.JoinWaitlistRequest{ClientId: "c2"})
+	second := b.joinWaitlist(t, &pb.JoinWaitlistRequest{ClientId: "c1"})
+
+	// The released hold is offered to the oldest entry
+	if err := b.service.CleanupExpiredReservations(ctx); err != nil {
+		t.Fatalf("CleanupExpiredReservations: %v", err)
+	}
+	if entry := b.waitlistEntry(t, "c2", first); entry.Status != models.WaitlistOffered || entry.Offer.GetId() != slotID {
+		t.Fatalf("got entry %v, want an offer of slot %s", entry, slotID)
+	}
+	if entry := b.waitlistEntry(t, "c1", second); entry.Status != models.WaitlistWaiting {
+		t.Fatalf("got status %s for the second entry, want Waiting", entry.Status)
+	}
+
+	// The offer lapses before c2 accepts it, and the slot moves on to the next
+	// entry
+	_, err := b.service.AcceptWaitlistOffer(asClient("c2"), &pb.AcceptWaitlistOfferRequest{Id: first})
+	wantCode(t, err, twirp.FailedPrecondition)
+	if err := b.service.CleanupExpiredReservations(ctx); err != nil {
+		t.Fatalf("CleanupExpiredReservations: %v", err)
+	}
+	if entry := b.waitlistEntry(t, "c2", first); entry.Status != models.WaitlistExpired {
+		t.Fatalf("got status %s after the offer lapsed, want Expired", entry.Status)
+	}
+	if entry := b.waitlistEntry(t, "c1", second); entry.Status != models.WaitlistOffered {
+		t.Fatalf("got status %s for the second entry, want Offered", entry.Status)
+	}
+}
+
+func TestCleanupExpiredReservationsExpiresStaleWaitlistEntries(t *testing.T) {
+	b := newTestBooking(t)
+	ctx := context.Background()
+	current := b.joinWaitlist(t, &pb.JoinWaitlistRequest{ClientId: "c1"})
+
+	// An entry whose last day has passed cannot be created through the
+	// service, so store it directly
+	yesterday := time.Now().UTC().AddDate(0, 0, -1).Format(time.DateOnly)
+	stale := models.WaitlistEntry{
+		ID:         generateID(),
+		ClientID:   "c1",
+		ProviderID: "p1",
+		StartDate:  yesterday,
+		EndDate:    yesterday,
+		Status:     models.WaitlistWaiting,
+		CreatedAt:  time.Now().UTC(),
+	}
+	if err := b.repo.CreateWaitlistEntry(ctx, stale); err != nil {
+		t.Fatalf("CreateWaitlistEntry: %v", err)
+	}
+
+	if err := b.service.CleanupExpiredReservations(ctx); err != nil {
+		t.Fatalf("CleanupExpiredReservations: %v", err)
+	}
+	if entry := b.waitlistEntry(t, "c1", stale.ID); entry.Status != models.WaitlistExpired {
+		t.Fatalf("got status %s for the stale entry, want Expired", entry.Status)
+	}
+	if entry := b.waitlistEntry(t, "c1", current); entry.Status != models.WaitlistWaiting {
+		t.Fatalf("got status %s for the current entry, want Waiting", entry.Status)
+	}
+}
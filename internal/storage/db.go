@@ -1,62 +1,132 @@
 package storage
 
 import (
-	"log"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
 
+	"github.com/manueldelreal/health-reservation-system/internal/migrations"
 	"github.com/manueldelreal/health-reservation-system/internal/models"
+	"gorm.io/driver/postgres"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
 )
 
-type DBHandler interface {
-	First(dest interface{}, args ...interface{}) error
-	Create(value interface{}) error
-	Transaction(txFunc func(tx *gorm.DB) error) error
+// GormRepository is the Repository backed by a GORM database.
+type GormRepository struct {
+	db *gorm.DB
 }
 
-type GormDBHandler struct {
-	DB *gorm.DB
+// NewGormRepository returns a Repository that stores its data in db.
+func NewGormRepository(db *gorm.DB) *GormRepository {
+	return &GormRepository{db: db}
 }
 
-func (g *GormDBHandler) First(dest interface{}, args ...interface{}) error {
-	result := g.DB.First(dest, args...)
-	return result.Error
+// Close closes the database connections.
+func (r *GormRepository) Close() error {
+	sqlDB, err := r.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
 }
 
-func (g *GormDBHandler) Create(value interface{}) error {
-	result := g.DB.Create(value)
-	return result.Error
+// Ping checks that the database can be reached.
+func (r *GormRepository) Ping(ctx context.Context) error {
+	sqlDB, err := r.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
 }
 
-func (g *GormDBHandler) Transaction(txFunc func(tx *gorm.DB) error) error {
-	return g.DB.Transaction(txFunc)
+// CheckMigrations returns an error if any migration has not been applied.
+func (r *GormRepository) CheckMigrations(ctx context.Context) error {
+	pending, err := migrations.Pending(r.db.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("%d migrations pending, next is %04d_%s", len(pending), pending[0].Version, pending[0].Name)
+	}
+	return nil
 }
 
-func (g *GormDBHandler) GetDB() *gorm.DB {
-	return g.DB
+// openDialector picks the database driver for a DSN. PostgreSQL URLs
+// (postgres:// or postgresql://) and key/value DSNs (host=... dbname=...) use
+// PostgreSQL; anything else is treated as a SQLite file.
+func openDialector(dsn string) gorm.Dialector {
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") || strings.Contains(dsn, "host=") {
+		return postgres.Open(dsn)
+	}
+	return sqlite.Open(dsn)
 }
 
-var DB DBHandler
+// schemaModels are the models whose tables the migrations create.
+var schemaModels = []interface{}{
+	&models.Provider{},
+	&models.Client{},
+	&models.Availability{},
+	&models.AvailabilityRule{},
+	&models.AvailabilityRuleException{},
+	&models.Slot{},
+	&models.AppointmentType{},
+	&models.Reservation{},
+	&models.ReservationSlot{},
+	&models.Cancellation{},
+	&models.IdempotencyKey{},
+	&models.WaitlistEntry{},
+}
 
-func ConnectDatabase(dsn string) {
-	// Open SQLite database
-	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+// OpenDatabase opens the SQLite or PostgreSQL database for a DSN, without
+// touching its schema. Every statement is traced.
+func OpenDatabase(dsn string) (*gorm.DB, error) {
+	db, err := gorm.Open(openDialector(dsn), &gorm.Config{
+		Logger:         slogLogger{level: logger.Warn},
+		TranslateError: true,
+	})
 	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
+		return nil, err
+	}
+	if err := db.Use(tracingPlugin{}); err != nil {
+		return nil, err
 	}
-	handler := &GormDBHandler{DB: db}
-	DB = handler
+	return db, nil
+}
+
+// CheckSchema reports any difference between the database schema and the
+// models, such as a model field without a column.
+func CheckSchema(db *gorm.DB) error {
+	return migrations.Check(db, schemaModels...)
+}
+
+func ConnectDatabase(dsn string) *GormRepository {
+	// Open the SQLite or PostgreSQL database
+	db, err := OpenDatabase(dsn)
+	if err != nil {
+		slog.Error("Failed to connect to database", "error", err)
+		os.Exit(1)
+	}
+	slog.Info("Connected to database", "dialect", db.Dialector.Name())
 
 	// Run migrations
-	log.Println("Checking and applying migrations...")
-	err = handler.GetDB().AutoMigrate(
-		&models.Provider{},
-		&models.Availability{},
-		&models.Slot{},
-		&models.Reservation{},
-	)
+	slog.Info("Checking and applying migrations")
+	applied, err := migrations.Up(db)
 	if err != nil {
-		log.Fatalf("Failed to migrate database: %v", err)
+		slog.Error("Failed to migrate database", "error", err)
+		os.Exit(1)
+	}
+	for _, migration := range applied {
+		slog.Info("Applied migration", "version", migration.Version, "name", migration.Name)
 	}
-	log.Println("Database migrations applied successfully.")
+	if err := CheckSchema(db); err != nil {
+		slog.Error("Database schema does not match the models", "error", err)
+		os.Exit(1)
+	}
+	slog.Info("Database migrations applied successfully")
+
+	return NewGormRepository(db)
 }
@@ -0,0 +1,293 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	embeddedpostgres "github.com/fergusstrange/embedded-postgres"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+
+	"github.com/manueldelreal/health-reservation-system/internal/migrations"
+	"github.com/manueldelreal/health-reservation-system/internal/models"
+)
+
+// postgresDSNEnv names the environment variable holding the DSN of a
+// PostgreSQL server to test against instead of an embedded one.
+const postgresDSNEnv = "HRS_TEST_POSTGRES_DSN"
+
+// testPostgres is the PostgreSQL server shared by the tests of this package,
+// started on first use.
+var testPostgres struct {
+	once    sync.Once
+	dsn     string
+	err     error
+	server  *embeddedpostgres.EmbeddedPostgres
+	runtime string
+}
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	if testPostgres.server != nil {
+		if err := testPostgres.server.Stop(); err != nil {
+			fmt.Fprintln(os.Stderr, "failed to stop embedded PostgreSQL:", err)
+		}
+		os.RemoveAll(testPostgres.runtime)
+	}
+	os.Exit(code)
+}
+
+// postgresServerDSN returns the DSN of the PostgreSQL server to test against:
+// the one in HRS_TEST_POSTGRES_DSN, or else an embedded server started for the
+// test binary. The test is skipped when neither is available.
+func postgresServerDSN(t *testing.T) string {
+	t.Helper()
+	testPostgres.once.Do(func() {
+		if dsn := os.Getenv(postgresDSNEnv); dsn != "" {
+			testPostgres.dsn = dsn
+			return
+		}
+		if testing.Short() {
+			testPostgres.err = fmt.Errorf("embedded PostgreSQL is not started in short mode, set %s to use a running server", postgresDSNEnv)
+			return
+		}
+		testPostgres.dsn, testPostgres.err = startEmbeddedPostgres()
+	})
+	if testPostgres.err != nil {
+		t.Skipf("PostgreSQL is not available: %v", testPostgres.err)
+	}
+	return testPostgres.dsn
+}
+
+// startEmbeddedPostgres downloads, initializes and starts a PostgreSQL server
+// on a free port and returns its DSN.
+func startEmbeddedPostgres() (string, error) {
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return "", err
+	}
+	port := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+
+	runtime, err := os.MkdirTemp("", "hrs-postgres-")
+	if err != nil {
+		return "", err
+	}
+	config := embeddedpostgres.DefaultConfig().
+		Port(uint32(port)).
+		RuntimePath(runtime).
+		DataPath(filepath.Join(runtime, "data")).
+		StartTimeout(time.Minute).
+		Logger(io.Discard)
+	server := embeddedpostgres.NewDatabase(config)
+	if err := server.Start(); err != nil {
+		os.RemoveAll(runtime)
+		return "", err
+	}
+	testPostgres.server = server
+	testPostgres.runtime = runtime
+	return config.GetConnectionURL() + "?sslmode=disable", nil
+}
+
+// openTestPostgres returns a migrated database in a schema of its own on the
+// test PostgreSQL server. The schema is dropped when the test ends.
+func openTestPostgres(t *testing.T) *gorm.DB {
+	t.Helper()
+	serverDSN := postgresServerDSN(t)
+
+	admin, err := OpenDatabase(serverDSN)
+	if err != nil {
+		t.Fatalf("failed to connect to PostgreSQL: %v", err)
+	}
+	schema := "test_" + strings.ToLower(ulid.Make().String())
+	if err := admin.Exec("CREATE SCHEMA " + schema).Error; err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := admin.Exec("DROP SCHEMA " + schema + " CASCADE").Error; err != nil {
+			t.Errorf("failed to drop schema: %v", err)
+		}
+		closeDB(t, admin)
+	})
+
+	db, err := OpenDatabase(withSearchPath(serverDSN, schema))
+	if err != nil {
+		t.Fatalf("failed to connect to PostgreSQL: %v", err)
+	}
+	t.Cleanup(func() { closeDB(t, db) })
+	migrate(t, db)
+	return db
+}
+
+// openTestSQLite returns a migrated SQLite database in a temporary file.
+func openTestSQLite(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := OpenDatabase(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("failed to open SQLite: %v", err)
+	}
+	t.Cleanup(func() { closeDB(t, db) })
+	migrate(t, db)
+	return db
+}
+
+// withSearchPath points the unqualified table names of a PostgreSQL URL or
+// key/value DSN at schema.
+func withSearchPath(dsn, schema string) string {
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		parsed, err := url.Parse(dsn)
+		if err == nil {
+			query := parsed.Query()
+			query.Set("search_path", schema)
+			parsed.RawQuery = query.Encode()
+			return parsed.String()
+		}
+	}
+	return dsn + " search_path=" + schema
+}
+
+func migrate(t *testing.T, db *gorm.DB) {
+	t.Helper()
+	if _, err := migrations.Up(db); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+}
+
+func closeDB(t *testing.T, db *gorm.DB) {
+	t.Helper()
+	sqlDB, err := db.DB()
+	if err == nil {
+		err = sqlDB.Close()
+	}
+	if err != nil {
+		t.Errorf("failed to close database: %v", err)
+	}
+}
+
+func TestCheckSchemaAfterMigrations(t *testing.T) {
+	for name, open := range map[string]func(*testing.T) *gorm.DB{
+		"sqlite":   openTestSQLite,
+		"postgres": openTestPostgres,
+	} {
+		t.Run(name, func(t *testing.T) {
+			db := open(t)
+			if err := CheckSchema(db); err != nil {
+				t.Fatalf("CheckSchema after migrating up: %v", err)
+			}
+
+			// Reverting every migration and applying them again ends in the
+			// same schema
+			applied, err := migrations.Applied(db)
+			if err != nil {
+				t.Fatalf("failed to list applied migrations: %v", err)
+			}
+			if _, err := migrations.Down(db, len(applied)); err != nil {
+				t.Fatalf("failed to migrate down: %v", err)
+			}
+			migrate(t, db)
+			if err := CheckSchema(db); err != nil {
+				t.Fatalf("CheckSchema after migrating down and up: %v", err)
+			}
+		})
+	}
+}
+
+func TestCheckSchemaReportsColumnTypes(t *testing.T) {
+	db, err := OpenDatabase(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("failed to open SQLite: %v", err)
+	}
+	t.Cleanup(func() { closeDB(t, db) })
+
+	// A providers table whose active column holds text instead of booleans
+	err = db.Exec(`CREATE TABLE providers (
+		id TEXT PRIMARY KEY,
+		name TEXT,
+		slot_duration_minutes INTEGER,
+		buffer_before_minutes INTEGER,
+		buffer_after_minutes INTEGER,
+		min_lead_time_minutes INTEGER,
+		time_zone TEXT,
+		active TEXT
+	)`).Error
+	if err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+
+	err = migrations.Check(db, &models.Provider{})
+	if err == nil || !strings.Contains(err.Error(), "column providers.active is TEXT") {
+		t.Fatalf("Check: got %v, want a type mismatch for providers.active", err)
+	}
+	if strings.Contains(err.Error(), "providers.name") {
+		t.Fatalf("Check reported a matching column: %v", err)
+	}
+}
+
+func TestMigrateRefusesUnversionedDatabase(t *testing.T) {
+	db, err := OpenDatabase(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("failed to open SQLite: %v", err)
+	}
+	t.Cleanup(func() { closeDB(t, db) })
+
+	// A providers table as AutoMigrate created it, without the later columns
+	err = db.Exec(`CREATE TABLE providers (id TEXT PRIMARY KEY, name TEXT)`).Error
+	if err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+
+	if _, err := migrations.Up(db); !errors.Is(err, migrations.ErrUnversioned) {
+		t.Fatalf("Up on an unversioned database: got %v, want ErrUnversioned", err)
+	}
+	if db.Migrator().HasTable("clients") {
+		t.Fatalf("Up created tables in an unversioned database")
+	}
+}
+
+func TestLeadTimeMigrationKeepsDefaults(t *testing.T) {
+	for name, open := range map[string]func(*testing.T) *gorm.DB{
+		"sqlite":   openTestSQLite,
+		"postgres": openTestPostgres,
+	} {
+		t.Run(name, func(t *testing.T) {
+			db := open(t)
+
+			// Before the migration, 0 meant the default lead time
+			if _, err := migrations.Down(db, 1); err != nil {
+				t.Fatalf("failed to migrate down: %v", err)
+			}
+			err := db.Exec(`INSERT INTO providers (id, name, min_lead_time_minutes) VALUES ('default', 'Default', 0), ('custom', 'Custom', 45)`).Error
+			if err != nil {
+				t.Fatalf("failed to insert providers: %v", err)
+			}
+			migrate(t, db)
+
+			repo := NewGormRepository(db)
+			ctx := context.Background()
+			unset, err := repo.GetProvider(ctx, "default")
+			if err != nil {
+				t.Fatalf("GetProvider: %v", err)
+			}
+			if unset.MinLeadTimeMinutes != nil {
+				t.Fatalf("got lead time %d for a provider on the default, want none", *unset.MinLeadTimeMinutes)
+			}
+			custom, err := repo.GetProvider(ctx, "custom")
+			if err != nil {
+				t.Fatalf("GetProvider: %v", err)
+			}
+			if custom.MinLeadTimeMinutes == nil || *custom.MinLeadTimeMinutes != 45 {
+				t.Fatalf("got lead time %v, want 45", custom.MinLeadTimeMinutes)
+			}
+		})
+	}
+}
@@ -0,0 +1,1142 @@
+package storage
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/manueldelreal/health-reservation-system/internal/models"
+)
+
+// MemoryRepository is a Repository that keeps all data in memory, for tests and
+// demos. Everything is lost when the process exits.
+type MemoryRepository struct {
+	mu               sync.Mutex
+	providers        map[string]models.Provider
+	clients          map[string]models.Client
+	availabilities   map[string]models.Availability
+	rules            map[string]models.AvailabilityRule
+	appointmentTypes map[string]models.AppointmentType
+	slots            map[string]models.Slot
+	reservations     map[string]models.Reservation
+	cancellations    []models.Cancellation
+	waitlist         map[string]models.WaitlistEntry
+	idempotencyKeys  map[string]models.IdempotencyKey
+}
+
+// NewMemoryRepository returns an empty in-memory Repository.
+func NewMemoryRepository() *MemoryRepository {
+	return &MemoryRepository{
+		providers:        make(map[string]models.Provider),
+		clients:          make(map[string]models.Client),
+		availabilities:   make(map[string]models.Availability),
+		rules:            make(map[string]models.AvailabilityRule),
+		appointmentTypes: make(map[string]models.AppointmentType),
+		slots:            make(map[string]models.Slot),
+		reservations:     make(map[string]models.Reservation),
+		waitlist:         make(map[string]models.WaitlistEntry),
+		idempotencyKeys:  make(map[string]models.IdempotencyKey),
+	}
+}
+
+// Ping always succeeds, as there is no database to reach.
+func (m *MemoryRepository) Ping(ctx context.Context) error {
+	return nil
+}
+
+// CreateProvider saves a new provider.
+func (m *MemoryRepository) CreateProvider(ctx context.Context, provider models.Provider) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.providers[provider.ID]; ok {
+		return ErrAlreadyExists
+	}
+	m.providers[provider.ID] = provider
+	return nil
+}
+
+// GetProvider fetches a provider by ID.
+func (m *MemoryRepository) GetProvider(ctx context.Context, providerID string) (models.Provider, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	provider, ok := m.providers[providerID]
+	if !ok {
+		return models.Provider{}, ErrNotFound
+	}
+	return provider, nil
+}
+
+// UpdateProvider saves a provider's name, slot settings and time zone.
+func (m *MemoryRepository) UpdateProvider(ctx context.Context, provider models.Provider) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.providers[provider.ID]; !ok {
+		return ErrNotFound
+	}
+	m.providers[provider.ID] = provider
+	return nil
+}
+
+// DeactivateProvider marks a provider inactive and expires the entries still
+// waiting on their waitlist.
+func (m *MemoryRepository) DeactivateProvider(ctx context.Context, providerID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	provider, ok := m.providers[providerID]
+	if !ok {
+		return &NotFoundError{Kind: "provider", ID: providerID}
+	}
+	provider.Active = false
+	m.providers[providerID] = provider
+
+	for id, entry := range m.waitlist {
+		if entry.ProviderID == providerID && entry.Status == models.WaitlistWaiting {
+			entry.Status = models.WaitlistExpired
+			m.waitlist[id] = entry
+		}
+	}
+	return nil
+}
+
+// ListProviders fetches the providers ordered by ID, leaving out inactive ones
+// unless includeInactive is set.
+func (m *MemoryRepository) ListProviders(ctx context.Context, includeInactive bool) ([]models.Provider, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var providers []models.Provider
+	for _, provider := range m.providers {
+		if provider.Active || includeInactive {
+			providers = append(providers, provider)
+		}
+	}
+	sort.Slice(providers, func(i, j int) bool { return providers[i].ID < providers[j].ID })
+	return providers, nil
+}
+
+// CreateClient saves a new client.
+func (m *MemoryRepository) CreateClient(ctx context.Context, client models.Client) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.clients[client.ID]; ok || m.externalIDTaken(client) {
+		return ErrAlreadyExists
+	}
+	m.clients[client.ID] = client
+	return nil
+}
+
+// GetClient fetches a client by ID.
+func (m *MemoryRepository) GetClient(ctx context.Context, clientID string) (models.Client, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	client, ok := m.clients[clientID]
+	if !ok {
+		return models.Client{}, ErrNotFound
+	}
+	return client, nil
+}
+
+// UpdateClient saves a client's name, contact details, date of birth and
+// external identifier.
+func (m *MemoryRepository) UpdateClient(ctx context.Context, client models.Client) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.clients[client.ID]; !ok {
+		return ErrNotFound
+	}
+	if m.externalIDTaken(client) {
+		return ErrAlreadyExists
+	}
+	m.clients[client.ID] = client
+	return nil
+}
+
+// externalIDTaken reports whether another client has the client's external
+// identifier. The caller must hold m.mu.
+func (m *MemoryRepository) externalIDTaken(client models.Client) bool {
+	if client.ExternalID == "" {
+		return false
+	}
+	for _, other := range m.clients {
+		if other.ID != client.ID && other.ExternalID == client.ExternalID {
+			return true
+		}
+	}
+	return false
+}
+
+// FindAvailability fetches a provider's availability with exactly the given
+// start and end times.
+func (m *MemoryRepository) FindAvailability(ctx context.Context, providerID string, startTime, endTime time.Time) (models.Availability, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, availability := range m.availabilities {
+		if availability.ProviderID == providerID && availability.StartTime.Equal(startTime) && availability.EndTime.Equal(endTime) {
+			return availability, nil
+		}
+	}
+	return models.Availability{}, ErrNotFound
+}
+
+// GetAvailabilities fetches a provider's availability windows that overlap the
+// given times, ordered by start time.
+func (m *MemoryRepository) GetAvailabilities(ctx context.Context, providerID string, startTime, endTime time.Time) ([]models.Availability, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var availabilities []models.Availability
+	for _, availability := range m.availabilities {
+		if availability.ProviderID == providerID && availability.StartTime.Before(endTime) && availability.EndTime.After(startTime) {
+			availabilities = append(availabilities, availability)
+		}
+	}
+	sort.Slice(availabilities, func(i, j int) bool {
+		return availabilities[i].StartTime.Before(availabilities[j].StartTime)
+	})
+	return availabilities, nil
+}
+
+// AddAvailabilityAndSlots saves availability and corresponding slots atomically.
+// Merged windows are grown first, so new slots may belong to them.
+func (m *MemoryRepository) AddAvailabilityAndSlots(ctx context.Context, providerID string, merges []AvailabilityMerge, availabilities []models.Availability, slots []models.Slot) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, merge := range merges {
+		if _, ok := m.availabilities[merge.AvailabilityID]; !ok {
+			return &NotFoundError{Kind: "availability", ID: merge.AvailabilityID}
+		}
+	}
+	for _, availability := range availabilities {
+		if _, ok := m.availabilities[availability.ID]; ok {
+			return ErrAlreadyExists
+		}
+	}
+	for _, slot := range slots {
+		if _, ok := m.slots[slot.ID]; ok {
+			return ErrAlreadyExists
+		}
+	}
+
+	for _, merge := range merges {
+		m.mergeAvailability(merge)
+	}
+	for _, availability := range availabilities {
+		m.availabilities[availability.ID] = availability
+	}
+	for _, slot := range slots {
+		slot.ProviderID = providerID
+		m.slots[slot.ID] = slot
+	}
+	return nil
+}
+
+// mergeAvailability grows a window and moves the slots and reservations of the
+// windows it absorbs into it. The caller must hold m.mu.
+func (m *MemoryRepository) mergeAvailability(merge AvailabilityMerge) {
+	absorbed := make(map[string]bool)
+	for _, id := range merge.Absorbed {
+		absorbed[id] = true
+		delete(m.availabilities, id)
+	}
+
+	for id, slot := range m.slots {
+		if absorbed[slot.AvailabilityID] {
+			slot.AvailabilityID = merge.AvailabilityID
+			m.slots[id] = slot
+		}
+	}
+	for id, reservation := range m.reservations {
+		if absorbed[reservation.AvailabilityID] {
+			reservation.AvailabilityID = merge.AvailabilityID
+		}
+		for i := range reservation.HeldSlots {
+			if absorbed[reservation.HeldSlots[i].AvailabilityID] {
+				reservation.HeldSlots[i].AvailabilityID = merge.AvailabilityID
+			}
+		}
+		m.reservations[id] = reservation
+	}
+
+	availability := m.availabilities[merge.AvailabilityID]
+	availability.StartTime, availability.EndTime = merge.StartTime.UTC(), merge.EndTime.UTC()
+	m.availabilities[merge.AvailabilityID] = availability
+}
+
+// ResizeAvailability shrinks an availability window to the given times and
+// deletes the Available slots that no longer fit. The reservations of the
+// cancellations are cancelled first, and the cancellations recorded are
+// returned. It fails with ErrAvailabilityReserved if any other reservation
+// lies outside the new times.
+func (m *MemoryRepository) ResizeAvailability(ctx context.Context, availabilityID string, startTime, endTime time.Time, cancellations []models.Cancellation) ([]models.Cancellation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	availability, ok := m.availabilities[availabilityID]
+	if !ok {
+		return nil, &NotFoundError{Kind: "availability", ID: availabilityID}
+	}
+	outside := func(start, end time.Time) bool {
+		return start.Before(startTime) || end.After(endTime)
+	}
+
+	// Check everything before changing anything
+	cancelling := cancelledReservations(cancellations)
+	for _, reservation := range m.reservations {
+		if reservation.AvailabilityID == availabilityID && outside(reservation.StartTime, reservation.EndTime) && !cancelling[reservation.ID] {
+			return nil, ErrAvailabilityReserved
+		}
+	}
+	for _, other := range m.availabilities {
+		if other.ID != availabilityID && other.ProviderID == availability.ProviderID && other.StartTime.Equal(startTime) && other.EndTime.Equal(endTime) {
+			return nil, ErrAlreadyExists
+		}
+	}
+	recorded := m.cancelReservations(cancellations)
+
+	// Delete the slots outside the new times and move the window
+	for id, slot := range m.slots {
+		if slot.AvailabilityID == availabilityID && outside(slot.StartTime, slot.EndTime) {
+			delete(m.slots, id)
+		}
+	}
+	availability.StartTime, availability.EndTime = startTime.UTC(), endTime.UTC()
+	m.availabilities[availabilityID] = availability
+	return recorded, nil
+}
+
+// RemoveAvailability deletes an availability window and its Available slots.
+// The reservations of the cancellations are cancelled first, and the
+// cancellations recorded are returned. It fails with ErrAvailabilityReserved
+// if the window has any other reservation.
+func (m *MemoryRepository) RemoveAvailability(ctx context.Context, availabilityID string, cancellations []models.Cancellation) ([]models.Cancellation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.availabilities[availabilityID]; !ok {
+		return nil, &NotFoundError{Kind: "availability", ID: availabilityID}
+	}
+	cancelling := cancelledReservations(cancellations)
+	for _, reservation := range m.reservations {
+		if reservation.AvailabilityID == availabilityID && !cancelling[reservation.ID] {
+			return nil, ErrAvailabilityReserved
+		}
+	}
+	recorded := m.cancelReservations(cancellations)
+
+	for id, slot := range m.slots {
+		if slot.AvailabilityID == availabilityID {
+			delete(m.slots, id)
+		}
+	}
+	delete(m.availabilities, availabilityID)
+	return recorded, nil
+}
+
+// cancelledReservations returns the IDs of the reservations of cancellations.
+func cancelledReservations(cancellations []models.Cancellation) map[string]bool {
+	ids := make(map[string]bool, len(cancellations))
+	for _, cancellation := range cancellations {
+		ids[cancellation.ReservationID] = true
+	}
+	return ids
+}
+
+// cancelReservations cancels the reservations of an availability change and
+// declines the waitlist offers they held. Reservations already cancelled or
+// released are skipped, and the cancellations recorded are returned. The
+// caller must hold m.mu.
+func (m *MemoryRepository) cancelReservations(cancellations []models.Cancellation) []models.Cancellation {
+	var recorded []models.Cancellation
+	for _, cancellation := range cancellations {
+		if m.cancelReservation(&cancellation) != nil {
+			continue
+		}
+		m.closeWaitlistOffer(cancellation.ReservationID, models.WaitlistDeclined)
+		recorded = append(recorded, cancellation)
+	}
+	return recorded
+}
+
+// CreateAvailabilityRule saves a recurring availability rule and its exceptions.
+func (m *MemoryRepository) CreateAvailabilityRule(ctx context.Context, rule *models.AvailabilityRule) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.rules[rule.ID]; ok {
+		return ErrAlreadyExists
+	}
+	stored := *rule
+	stored.Exceptions = append([]models.AvailabilityRuleException(nil), rule.Exceptions...)
+	m.rules[rule.ID] = stored
+	return nil
+}
+
+// GetAvailabilityRule fetches an availability rule and its exceptions.
+func (m *MemoryRepository) GetAvailabilityRule(ctx context.Context, ruleID string) (models.AvailabilityRule, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	rule, ok := m.rules[ruleID]
+	if !ok {
+		return models.AvailabilityRule{}, ErrNotFound
+	}
+	return rule, nil
+}
+
+// GetAvailabilityRules returns the availability rules of a provider, or of every
+// provider when providerID is empty.
+func (m *MemoryRepository) GetAvailabilityRules(ctx context.Context, providerID string) ([]models.AvailabilityRule, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var rules []models.AvailabilityRule
+	for _, rule := range m.rules {
+		if providerID == "" || rule.ProviderID == providerID {
+			rules = append(rules, rule)
+		}
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].ID < rules[j].ID })
+	return rules, nil
+}
+
+// DeleteAvailabilityRule deletes a rule together with the future Available slots
+// materialized from it. Windows that still hold reservations are kept.
+func (m *MemoryRepository) DeleteAvailabilityRule(ctx context.Context, ruleID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.rules[ruleID]; !ok {
+		return &NotFoundError{Kind: "availability rule", ID: ruleID}
+	}
+
+	m.clearRuleWindows(ruleID, time.Now(), time.Time{})
+	delete(m.rules, ruleID)
+	return nil
+}
+
+// AddAvailabilityRuleException records a day a rule does not apply and removes
+// the Available slots materialized from the rule between dayStart and dayEnd.
+// Windows that still hold reservations are kept.
+func (m *MemoryRepository) AddAvailabilityRuleException(ctx context.Context, exception models.AvailabilityRuleException, dayStart, dayEnd time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	rule, ok := m.rules[exception.RuleID]
+	if !ok {
+		return &NotFoundError{Kind: "availability rule", ID: exception.RuleID}
+	}
+	for _, existing := range rule.Exceptions {
+		if existing.ID == exception.ID {
+			return ErrAlreadyExists
+		}
+	}
+	rule.Exceptions = append(append([]models.AvailabilityRuleException(nil), rule.Exceptions...), exception)
+	m.rules[rule.ID] = rule
+
+	from := time.Now()
+	if dayStart.After(from) {
+		from = dayStart
+	}
+	m.clearRuleWindows(rule.ID, from, dayEnd)
+	return nil
+}
+
+// clearRuleWindows deletes the Available slots of a rule's windows that start
+// at or after from, and before to unless it is zero, and then the windows that
+// have nothing left in them. The caller must hold m.mu.
+func (m *MemoryRepository) clearRuleWindows(ruleID string, from, to time.Time) {
+	for id, availability := range m.availabilities {
+		if availability.RuleID != ruleID || availability.StartTime.Before(from) {
+			continue
+		}
+		if !to.IsZero() && !availability.StartTime.Before(to) {
+			continue
+		}
+
+		// Remove the unreserved slots
+		for slotID, slot := range m.slots {
+			if slot.AvailabilityID == id && slot.Status == "Available" {
+				delete(m.slots, slotID)
+			}
+		}
+
+		// Remove the window if nothing is left in it
+		if !m.availabilityInUse(id) {
+			delete(m.availabilities, id)
+		}
+	}
+}
+
+// availabilityInUse reports whether any slot or reservation still belongs to
+// the availability window.
+func (m *MemoryRepository) availabilityInUse(availabilityID string) bool {
+	for _, slot := range m.slots {
+		if slot.AvailabilityID == availabilityID {
+			return true
+		}
+	}
+	for _, reservation := range m.reservations {
+		if reservation.AvailabilityID == availabilityID {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateAppointmentType saves an appointment type to a provider's catalog.
+func (m *MemoryRepository) CreateAppointmentType(ctx context.Context, appointmentType *models.AppointmentType) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.appointmentTypes[appointmentType.ID]; ok {
+		return ErrAlreadyExists
+	}
+	m.appointmentTypes[appointmentType.ID] = *appointmentType
+	return nil
+}
+
+// GetAppointmentType fetches an appointment type from a provider's catalog, or
+// from any provider's when providerID is empty.
+func (m *MemoryRepository) GetAppointmentType(ctx context.Context, appointmentTypeID, providerID string) (models.AppointmentType, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	appointmentType, ok := m.appointmentTypes[appointmentTypeID]
+	if !ok || (providerID != "" && appointmentType.ProviderID != providerID) {
+		return models.AppointmentType{}, ErrNotFound
+	}
+	return appointmentType, nil
+}
+
+// GetAppointmentTypes returns the appointment type catalog of a provider.
+func (m *MemoryRepository) GetAppointmentTypes(ctx context.Context, providerID string) ([]models.AppointmentType, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var appointmentTypes []models.AppointmentType
+	for _, appointmentType := range m.appointmentTypes {
+		if appointmentType.ProviderID == providerID {
+			appointmentTypes = append(appointmentTypes, appointmentType)
+		}
+	}
+	sort.Slice(appointmentTypes, func(i, j int) bool { return appointmentTypes[i].Name < appointmentTypes[j].Name })
+	return appointmentTypes, nil
+}
+
+// DeleteAppointmentType removes an appointment type from its provider's catalog.
+// Existing reservations for it are kept.
+func (m *MemoryRepository) DeleteAppointmentType(ctx context.Context, appointmentTypeID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.appointmentTypes[appointmentTypeID]; !ok {
+		return &NotFoundError{Kind: "appointment type", ID: appointmentTypeID}
+	}
+	delete(m.appointmentTypes, appointmentTypeID)
+	return nil
+}
+
+// GetAvailableSlot fetches a slot by ID if it is Available.
+func (m *MemoryRepository) GetAvailableSlot(ctx context.Context, slotID string) (models.Slot, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	slot, ok := m.slots[slotID]
+	if !ok || slot.Status != "Available" {
+		return models.Slot{}, ErrNotFound
+	}
+	return slot, nil
+}
+
+// GetAvailableSlots returns the Available slots of a provider that start on the
+// day beginning at date, a midnight in the provider's or client's time zone.
+func (m *MemoryRepository) GetAvailableSlots(ctx context.Context, providerID string, date time.Time) ([]models.Slot, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	start, end := dayBounds(date)
+	var slots []models.Slot
+	for _, slot := range m.slots {
+		if slot.ProviderID == providerID && slot.Status == "Available" && !slot.StartTime.Before(start) && slot.StartTime.Before(end) {
+			slots = append(slots, slot)
+		}
+	}
+	sortSlots(slots)
+	return slots, nil
+}
+
+// CountAvailableSlots returns the number of future Available slots of each
+// provider that has any.
+func (m *MemoryRepository) CountAvailableSlots(ctx context.Context) (map[string]int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	counts := make(map[string]int)
+	for _, slot := range m.slots {
+		if slot.Status == "Available" && slot.StartTime.After(now) {
+			counts[slot.ProviderID]++
+		}
+	}
+	return counts, nil
+}
+
+// GetReservation fetches a reservation by ID.
+func (m *MemoryRepository) GetReservation(ctx context.Context, reservationID string) (models.Reservation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	reservation, ok := m.reservations[reservationID]
+	if !ok {
+		return models.Reservation{}, ErrNotFound
+	}
+	return reservation, nil
+}
+
+// ReserveSlot claims count consecutive Available slots starting at slotID for a
+// reservation. The reservation's slot, provider and times are filled in from
+// the claimed slots.
+func (m *MemoryRepository) ReserveSlot(ctx context.Context, reservation models.Reservation, slotID string, count int, maxGap time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.reservations[reservation.ID]; ok {
+		return ErrAlreadyExists
+	}
+
+	slots, err := findSlotRun(m.slots, slotID, count, maxGap)
+	if err != nil {
+		return err
+	}
+
+	for _, slot := range slots {
+		delete(m.slots, slot.ID)
+	}
+	holdSlots(&reservation, slots)
+	m.reservations[reservation.ID] = reservation
+	return nil
+}
+
+// RescheduleReservation moves a reservation to count consecutive Available slots
+// starting at newSlotID. The old slots are returned to the pool and the
+// reservation keeps its ID, client and status. A hold that has expired cannot
+// be moved.
+func (m *MemoryRepository) RescheduleReservation(ctx context.Context, reservationID, newSlotID string, count int, maxGap time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	reservation, ok := m.reservations[reservationID]
+	if !ok {
+		return &NotFoundError{Kind: "reservation", ID: reservationID}
+	}
+	if holdExpired(reservation, time.Now()) {
+		return ErrHoldExpired
+	}
+
+	// Look for the new slots as if the old ones were already released, so
+	// nothing changes if they cannot be claimed
+	released := heldSlots(reservation)
+	pool := make(map[string]models.Slot, len(m.slots)+len(released))
+	for id, slot := range m.slots {
+		pool[id] = slot
+	}
+	for _, slot := range released {
+		pool[slot.ID] = slot
+	}
+
+	slots, err := findSlotRun(pool, newSlotID, count, maxGap)
+	if err != nil {
+		return err
+	}
+
+	for _, slot := range released {
+		m.slots[slot.ID] = slot
+	}
+	for _, slot := range slots {
+		delete(m.slots, slot.ID)
+	}
+	holdSlots(&reservation, slots)
+	m.reservations[reservation.ID] = reservation
+	return nil
+}
+
+// ConfirmReservation marks a reservation as Confirmed.
+func (m *MemoryRepository) ConfirmReservation(ctx context.Context, reservationID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	reservation, ok := m.reservations[reservationID]
+	if !ok {
+		return &NotFoundError{Kind: "reservation", ID: reservationID}
+	}
+
+	// Check if the reservation is already confirmed or its hold has expired
+	if err := checkHold(reservation, time.Now()); err != nil {
+		return err
+	}
+
+	reservation.Status = "Confirmed"
+	m.reservations[reservationID] = reservation
+	return nil
+}
+
+// ExtendHold pushes the expiry of an unconfirmed reservation back by
+// extension, at most maxExtensions times, and returns the updated reservation.
+func (m *MemoryRepository) ExtendHold(ctx context.Context, reservationID string, extension time.Duration, maxExtensions int) (models.Reservation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	reservation, ok := m.reservations[reservationID]
+	if !ok {
+		return models.Reservation{}, &NotFoundError{Kind: "reservation", ID: reservationID}
+	}
+	if err := checkHold(reservation, time.Now()); err != nil {
+		return models.Reservation{}, err
+	}
+	if reservation.HoldExtensions >= maxExtensions {
+		return models.Reservation{}, ErrHoldExtensionLimit
+	}
+
+	expiry := time.Now()
+	if reservation.ReservationExpiry != nil {
+		expiry = *reservation.ReservationExpiry
+	}
+	expiry = expiry.Add(extension).UTC()
+	reservation.ReservationExpiry = &expiry
+	reservation.HoldExtensions++
+	m.reservations[reservationID] = reservation
+	return reservation, nil
+}
+
+// ReplaceHoldToken stores the hash of a new hold token for an unconfirmed
+// reservation whose hold has not expired, and returns the reservation. The old
+// token stops working.
+func (m *MemoryRepository) ReplaceHoldToken(ctx context.Context, reservationID, tokenHash string) (models.Reservation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	reservation, ok := m.reservations[reservationID]
+	if !ok {
+		return models.Reservation{}, &NotFoundError{Kind: "reservation", ID: reservationID}
+	}
+	if err := checkHold(reservation, time.Now()); err != nil {
+		return models.Reservation{}, err
+	}
+
+	reservation.HoldTokenHash = tokenHash
+	m.reservations[reservationID] = reservation
+	return reservation, nil
+}
+
+// CancelReservation deletes a reservation, records who cancelled it and why, and
+// returns its slots to the pool as Available.
+func (m *MemoryRepository) CancelReservation(ctx context.Context, cancellation models.Cancellation) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.cancelReservation(&cancellation)
+}
+
+// cancelReservation deletes a reservation, records the cancellation with the
+// reservation's details filled in and returns its slots to the pool. The caller
+// must hold m.mu.
+func (m *MemoryRepository) cancelReservation(cancellation *models.Cancellation) error {
+	reservation, ok := m.reservations[cancellation.ReservationID]
+	if !ok {
+		return &NotFoundError{Kind: "reservation", ID: cancellation.ReservationID}
+	}
+
+	slots := heldSlots(reservation)
+	for _, slot := range slots {
+		m.slots[slot.ID] = slot
+	}
+
+	// Keep a record of the cancelled reservation
+	cancellation.SlotID = slots[0].ID
+	cancellation.ClientID = reservation.ClientID
+	cancellation.ProviderID = reservation.ProviderID
+	cancellation.StartTime = reservation.StartTime
+	cancellation.EndTime = reservation.EndTime
+	m.cancellations = append(m.cancellations, *cancellation)
+
+	delete(m.reservations, reservation.ID)
+	return nil
+}
+
+// CleanupExpiredReservations returns the slots of expired holds to the pool and
+// returns the reservations that expired.
+func (m *MemoryRepository) CleanupExpiredReservations(ctx context.Context) ([]models.Reservation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	slog.DebugContext(ctx, "Checking for expired reservations")
+
+	var expired []models.Reservation
+	for id, reservation := range m.reservations {
+		if reservation.Status != "Reserved" || reservation.ReservationExpiry == nil || !reservation.ReservationExpiry.Before(now) {
+			continue
+		}
+
+		for _, slot := range heldSlots(reservation) {
+			m.slots[slot.ID] = slot
+		}
+		delete(m.reservations, id)
+		logReleased(ctx, reservation)
+		expired = append(expired, reservation)
+	}
+
+	if len(expired) > 0 {
+		slog.InfoContext(ctx, "Expired reservations cleaned up", "count", len(expired))
+	}
+	return expired, nil
+}
+
+// GetReservationsByProvider returns a provider's reservations, optionally only
+// those starting on the day beginning at date.
+func (m *MemoryRepository) GetReservationsByProvider(ctx context.Context, providerID string, date *time.Time) ([]models.Reservation, error) {
+	return m.findReservations(func(reservation models.Reservation) bool {
+		return reservation.ProviderID == providerID
+	}, date)
+}
+
+// GetReservationsByClient returns a client's reservations, optionally only those
+// starting on the day beginning at date.
+func (m *MemoryRepository) GetReservationsByClient(ctx context.Context, clientID string, date *time.Time) ([]models.Reservation, error) {
+	return m.findReservations(func(reservation models.Reservation) bool {
+		return reservation.ClientID == clientID
+	}, date)
+}
+
+// GetReservationsByAvailability fetches the reservations held in an
+// availability window, ordered by start time.
+func (m *MemoryRepository) GetReservationsByAvailability(ctx context.Context, availabilityID string) ([]models.Reservation, error) {
+	return m.findReservations(func(reservation models.Reservation) bool {
+		return reservation.AvailabilityID == availabilityID
+	}, nil)
+}
+
+// CreateWaitlistEntry saves a new waitlist entry.
+func (m *MemoryRepository) CreateWaitlistEntry(ctx context.Context, entry models.WaitlistEntry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.waitlist[entry.ID]; ok {
+		return ErrAlreadyExists
+	}
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now().UTC()
+	}
+	m.waitlist[entry.ID] = entry
+	return nil
+}
+
+// GetWaitlistEntry fetches a waitlist entry by ID.
+func (m *MemoryRepository) GetWaitlistEntry(ctx context.Context, entryID string) (models.WaitlistEntry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, ok := m.waitlist[entryID]
+	if !ok {
+		return models.WaitlistEntry{}, ErrNotFound
+	}
+	return entry, nil
+}
+
+// GetWaitlistEntriesByClient fetches a client's waitlist entries, oldest
+// first.
+func (m *MemoryRepository) GetWaitlistEntriesByClient(ctx context.Context, clientID string) ([]models.WaitlistEntry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.findWaitlistEntries(func(entry models.WaitlistEntry) bool {
+		return entry.ClientID == clientID
+	}), nil
+}
+
+// GetWaitingEntries fetches the entries still waiting for a provider's slots,
+// in the order they joined.
+func (m *MemoryRepository) GetWaitingEntries(ctx context.Context, providerID string) ([]models.WaitlistEntry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.findWaitlistEntries(func(entry models.WaitlistEntry) bool {
+		return entry.ProviderID == providerID && entry.Status == models.WaitlistWaiting
+	}), nil
+}
+
+// OfferWaitlistSlot holds the slots starting at slotID for a waiting entry's
+// client and marks the entry Offered, atomically. An entry that is no longer
+// waiting returns ErrNotWaiting.
+func (m *MemoryRepository) OfferWaitlistSlot(ctx context.Context, entryID string, reservation models.Reservation, slotID string, count int, maxGap time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, ok := m.waitlist[entryID]
+	if !ok || entry.Status != models.WaitlistWaiting {
+		return ErrNotWaiting
+	}
+	if _, ok := m.reservations[reservation.ID]; ok {
+		return ErrAlreadyExists
+	}
+
+	slots, err := findSlotRun(m.slots, slotID, count, maxGap)
+	if err != nil {
+		return err
+	}
+	for _, slot := range slots {
+		delete(m.slots, slot.ID)
+	}
+	holdSlots(&reservation, slots)
+	m.reservations[reservation.ID] = reservation
+
+	entry.Status = models.WaitlistOffered
+	entry.ReservationID = reservation.ID
+	m.waitlist[entryID] = entry
+	return nil
+}
+
+// AcceptWaitlistOffer confirms the reservation offered to a waitlist entry and
+// marks the entry Accepted, unless the offer has expired.
+func (m *MemoryRepository) AcceptWaitlistOffer(ctx context.Context, entryID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, ok := m.waitlist[entryID]
+	if !ok {
+		return &NotFoundError{Kind: "waitlist entry", ID: entryID}
+	}
+	if entry.Status != models.WaitlistOffered {
+		return ErrNoOffer
+	}
+	reservation, ok := m.reservations[entry.ReservationID]
+	if !ok {
+		return ErrNoOffer
+	}
+	if err := checkHold(reservation, time.Now()); err != nil {
+		return err
+	}
+
+	reservation.Status = "Confirmed"
+	m.reservations[reservation.ID] = reservation
+	entry.Status = models.WaitlistAccepted
+	m.waitlist[entryID] = entry
+	return nil
+}
+
+// CloseWaitlistOffer gives the waitlist entry holding a reservation as an open
+// offer its final status, once the reservation has been released. Other
+// reservations are ignored.
+func (m *MemoryRepository) CloseWaitlistOffer(ctx context.Context, reservationID, status string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.closeWaitlistOffer(reservationID, status)
+	return nil
+}
+
+// closeWaitlistOffer gives the entry holding a reservation as an open offer its
+// final status. The caller must hold m.mu.
+func (m *MemoryRepository) closeWaitlistOffer(reservationID, status string) {
+	for id, entry := range m.waitlist {
+		if entry.ReservationID == reservationID && entry.Status == models.WaitlistOffered {
+			entry.Status = status
+			m.waitlist[id] = entry
+		}
+	}
+}
+
+// ExpireWaitlistEntries expires a provider's waiting entries whose last day,
+// in the provider's time zone, is before the given day, and returns how many
+// it expired.
+func (m *MemoryRepository) ExpireWaitlistEntries(ctx context.Context, providerID, day string) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	expired := 0
+	for id, entry := range m.waitlist {
+		if entry.ProviderID == providerID && entry.Status == models.WaitlistWaiting && entry.EndDate < day {
+			entry.Status = models.WaitlistExpired
+			m.waitlist[id] = entry
+			expired++
+		}
+	}
+	return expired, nil
+}
+
+// findWaitlistEntries returns the waitlist entries matching the filter, in the
+// order they joined. The caller must hold m.mu.
+func (m *MemoryRepository) findWaitlistEntries(match func(models.WaitlistEntry) bool) []models.WaitlistEntry {
+	var entries []models.WaitlistEntry
+	for _, entry := range m.waitlist {
+		if match(entry) {
+			entries = append(entries, entry)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if !entries[i].CreatedAt.Equal(entries[j].CreatedAt) {
+			return entries[i].CreatedAt.Before(entries[j].CreatedAt)
+		}
+		return entries[i].ID < entries[j].ID
+	})
+	return entries
+}
+
+// CreateIdempotencyKey records a request made with an idempotency key. A key
+// whose record has expired is reused, and one that has not returns
+// ErrAlreadyExists.
+func (m *MemoryRepository) CreateIdempotencyKey(ctx context.Context, key models.IdempotencyKey) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if existing, ok := m.idempotencyKeys[key.ID]; ok && existing.ExpiresAt.After(time.Now()) {
+		return ErrAlreadyExists
+	}
+	m.idempotencyKeys[key.ID] = key
+	return nil
+}
+
+// GetIdempotencyKey fetches the record of an idempotency key.
+func (m *MemoryRepository) GetIdempotencyKey(ctx context.Context, keyID string) (models.IdempotencyKey, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key, ok := m.idempotencyKeys[keyID]
+	if !ok {
+		return models.IdempotencyKey{}, ErrNotFound
+	}
+	return key, nil
+}
+
+// SaveIdempotentResponse stores the response to a request made with an
+// idempotency key, to be replayed until expiresAt.
+func (m *MemoryRepository) SaveIdempotentResponse(ctx context.Context, keyID string, response []byte, expiresAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key, ok := m.idempotencyKeys[keyID]
+	if !ok {
+		return ErrNotFound
+	}
+	key.Response = response
+	key.ExpiresAt = expiresAt
+	m.idempotencyKeys[keyID] = key
+	return nil
+}
+
+// DeleteIdempotencyKey deletes the record of an idempotency key, so that the
+// key can be used again.
+func (m *MemoryRepository) DeleteIdempotencyKey(ctx context.Context, keyID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.idempotencyKeys, keyID)
+	return nil
+}
+
+// DeleteExpiredIdempotencyKeys deletes the records of expired idempotency keys
+// and returns how many there were.
+func (m *MemoryRepository) DeleteExpiredIdempotencyKeys(ctx context.Context) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	deleted := 0
+	for id, key := range m.idempotencyKeys {
+		if !key.ExpiresAt.After(now) {
+			delete(m.idempotencyKeys, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// findReservations returns the reservations matching the filter, optionally
+// only those starting on the day beginning at date, ordered by start time.
+func (m *MemoryRepository) findReservations(match func(models.Reservation) bool, date *time.Time) ([]models.Reservation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var reservations []models.Reservation
+	for _, reservation := range m.reservations {
+		if !match(reservation) {
+			continue
+		}
+		if date != nil {
+			start, end := dayBounds(*date)
+			if reservation.StartTime.Before(start) || !reservation.StartTime.Before(end) {
+				continue
+			}
+		}
+		reservations = append(reservations, reservation)
+	}
+
+	sort.Slice(reservations, func(i, j int) bool {
+		return reservations[i].StartTime.Before(reservations[j].StartTime)
+	})
+	return reservations, nil
+}
+
+// findSlotRun returns a run of count consecutive Available slots from pool,
+// starting at startSlotID, in order.
+func findSlotRun(pool map[string]models.Slot, startSlotID string, count int, maxGap time.Duration) ([]models.Slot, error) {
+	first, ok := pool[startSlotID]
+	if !ok || first.Status != "Available" {
+		return nil, &SlotError{SlotID: startSlotID, Err: ErrSlotUnavailable}
+	}
+
+	// Collect the slots that follow it in the same availability window
+	var candidates []models.Slot
+	for _, slot := range pool {
+		if slot.AvailabilityID == first.AvailabilityID && slot.Status == "Available" && !slot.StartTime.Before(first.StartTime) {
+			candidates = append(candidates, slot)
+		}
+	}
+	sortSlots(candidates)
+
+	if len(candidates) < count {
+		return nil, &SlotError{SlotID: startSlotID, Err: ErrNotEnoughSlots}
+	}
+	slots := candidates[:count]
+	for i := 1; i < len(slots); i++ {
+		if !slots[i-1].Precedes(slots[i], maxGap) {
+			return nil, &SlotError{SlotID: startSlotID, Err: ErrNotEnoughSlots}
+		}
+	}
+	return slots, nil
+}
+
+// heldSlots rebuilds the Available slots that a reservation is holding.
+func heldSlots(reservation models.Reservation) []models.Slot {
+	var slots []models.Slot
+	for _, held := range reservation.HeldSlots {
+		slots = append(slots, models.Slot{
+			ID:             held.SlotID,
+			AvailabilityID: held.AvailabilityID,
+			ProviderID:     held.ProviderID,
+			StartTime:      held.StartTime,
+			EndTime:        held.EndTime,
+			Status:         "Available",
+		})
+	}
+	sortSlots(slots)
+	return slots
+}
+
+// sortSlots orders slots by start time.
+func sortSlots(slots []models.Slot) {
+	sort.Slice(slots, func(i, j int) bool {
+		return slots[i].StartTime.Before(slots[j].StartTime)
+	})
+}
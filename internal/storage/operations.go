@@ -1,133 +1,879 @@
 package storage
 
 import (
+	"context"
 	"errors"
-	"log"
+	"log/slog"
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/manueldelreal/health-reservation-system/internal/models"
 )
 
+// lockRows locks the rows selected by tx until the transaction ends. With
+// skipLocked, rows held by another transaction are left out instead of waited
+// for. SQLite locks the whole database for writing, so nothing is added there.
+func lockRows(tx *gorm.DB, skipLocked bool) *gorm.DB {
+	if tx.Dialector.Name() != "postgres" {
+		return tx
+	}
+	locking := clause.Locking{Strength: "UPDATE"}
+	if skipLocked {
+		locking.Options = "SKIP LOCKED"
+	}
+	return tx.Clauses(locking)
+}
+
+// first fetches the first record matching the conditions into dest, translating
+// GORM's not-found error into ErrNotFound.
+func (r *GormRepository) first(ctx context.Context, dest interface{}, conds ...interface{}) error {
+	err := r.db.WithContext(ctx).First(dest, conds...).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// duplicateError translates a unique constraint violation into
+// ErrAlreadyExists.
+func duplicateError(err error) error {
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return ErrAlreadyExists
+	}
+	return err
+}
+
+// CreateProvider saves a new provider.
+func (r *GormRepository) CreateProvider(ctx context.Context, provider models.Provider) error {
+	return duplicateError(r.db.WithContext(ctx).Create(&provider).Error)
+}
+
+// GetProvider fetches a provider by ID.
+func (r *GormRepository) GetProvider(ctx context.Context, providerID string) (models.Provider, error) {
+	var provider models.Provider
+	err := r.first(ctx, &provider, "id = ?", providerID)
+	return provider, err
+}
+
+// FindAvailability fetches a provider's availability with exactly the given
+// start and end times.
+func (r *GormRepository) FindAvailability(ctx context.Context, providerID string, startTime, endTime time.Time) (models.Availability, error) {
+	var availability models.Availability
+	err := r.first(ctx, &availability, "provider_id = ? AND start_time = ? AND end_time = ?", providerID, startTime.UTC(), endTime.UTC())
+	return availability, err
+}
+
+// GetAvailabilities fetches a provider's availability windows that overlap the
+// given times, ordered by start time.
+func (r *GormRepository) GetAvailabilities(ctx context.Context, providerID string, startTime, endTime time.Time) ([]models.Availability, error) {
+	var availabilities []models.Availability
+	err := r.db.WithContext(ctx).
+		Where("provider_id = ? AND start_time < ? AND end_time > ?", providerID, endTime.UTC(), startTime.UTC()).
+		Order("start_time").
+		Find(&availabilities).Error
+	return availabilities, err
+}
+
 // AddAvailabilityAndSlots saves availability and corresponding slots to the database in a single transaction.
-func AddAvailabilityAndSlots(providerID string, availabilities []models.Availability, slots []models.Slot) error {
+// Merged windows are grown first, so new slots may belong to them.
+func (r *GormRepository) AddAvailabilityAndSlots(ctx context.Context, providerID string, merges []AvailabilityMerge, availabilities []models.Availability, slots []models.Slot) error {
 	for i := range slots {
 		slots[i].ProviderID = providerID
 	}
 
-	return DB.Transaction(func(tx *gorm.DB) error {
-		txHandler := &GormDBHandler{DB: tx}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Grow merged windows and move everything from the ones they absorb
+		for _, merge := range merges {
+			if err := mergeAvailability(tx, merge); err != nil {
+				return err
+			}
+		}
 
 		// Save availabilities
-		if err := txHandler.Create(&availabilities); err != nil {
-			return err
+		if len(availabilities) > 0 {
+			if err := tx.Create(&availabilities).Error; err != nil {
+				return err
+			}
 		}
 
 		// Save slots
-		if err := txHandler.Create(&slots); err != nil {
-			return err
+		if len(slots) > 0 {
+			if err := tx.Create(&slots).Error; err != nil {
+				return err
+			}
 		}
 
 		return nil
 	})
+	return duplicateError(err)
 }
 
-func GetAvailableSlots(providerID string, date time.Time) ([]models.Slot, error) {
-	var slots []models.Slot
+// mergeAvailability grows a window and moves the slots and reservations of the
+// windows it absorbs into it. The absorbed windows are deleted first, as one of
+// them may already have the new times.
+func mergeAvailability(tx *gorm.DB, merge AvailabilityMerge) error {
+	if len(merge.Absorbed) > 0 {
+		for _, model := range []interface{}{&models.Slot{}, &models.Reservation{}, &models.ReservationSlot{}} {
+			err := tx.Model(model).Where("availability_id IN ?", merge.Absorbed).Update("availability_id", merge.AvailabilityID).Error
+			if err != nil {
+				return err
+			}
+		}
+		if err := tx.Where("id IN ?", merge.Absorbed).Delete(&models.Availability{}).Error; err != nil {
+			return err
+		}
+	}
 
-	start := date
-	end := date.Add(24 * time.Hour)
+	result := tx.Model(&models.Availability{}).Where("id = ?", merge.AvailabilityID).Updates(map[string]interface{}{
+		"start_time": merge.StartTime.UTC(),
+		"end_time":   merge.EndTime.UTC(),
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return &NotFoundError{Kind: "availability", ID: merge.AvailabilityID}
+	}
+	return nil
+}
 
-	// Use the First method for the subquery and Find for the main query
-	err := DB.(*GormDBHandler).GetDB().Where(
-		"availability_id IN (?) AND status = ?",
-		DB.(*GormDBHandler).GetDB().Model(&models.Availability{}).Select("id").Where(
-			"provider_id = ? AND start_time BETWEEN ? AND ?", providerID, start, end,
-		),
-		"Available",
-	).Find(&slots).Error
+// ResizeAvailability shrinks an availability window to the given times and
+// deletes the Available slots that no longer fit. The reservations of the
+// cancellations are cancelled first in the same transaction, and the
+// cancellations recorded are returned. It fails with ErrAvailabilityReserved
+// if any other reservation lies outside the new times.
+func (r *GormRepository) ResizeAvailability(ctx context.Context, availabilityID string, startTime, endTime time.Time, cancellations []models.Cancellation) ([]models.Cancellation, error) {
+	startTime, endTime = startTime.UTC(), endTime.UTC()
 
-	return slots, err
+	var recorded []models.Cancellation
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Lock the window so no slot of it is claimed meanwhile
+		var availability models.Availability
+		if err := lockRows(tx, false).First(&availability, "id = ?", availabilityID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return &NotFoundError{Kind: "availability", ID: availabilityID}
+			}
+			return err
+		}
+
+		var err error
+		recorded, err = cancelReservations(tx, cancellations)
+		if err != nil {
+			return err
+		}
+
+		outside := tx.Where("availability_id = ? AND (start_time < ? OR end_time > ?)", availabilityID, startTime, endTime)
+		var reserved int64
+		if err := outside.Model(&models.Reservation{}).Count(&reserved).Error; err != nil {
+			return err
+		}
+		if reserved > 0 {
+			return ErrAvailabilityReserved
+		}
+
+		// Delete the slots outside the new times and move the window
+		err = tx.Where("availability_id = ? AND (start_time < ? OR end_time > ?)", availabilityID, startTime, endTime).Delete(&models.Slot{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Model(&availability).Updates(map[string]interface{}{
+			"start_time": startTime,
+			"end_time":   endTime,
+		}).Error
+	})
+	if err != nil {
+		return nil, duplicateError(err)
+	}
+	return recorded, nil
 }
 
-func ReserveSlot(slotID, clientID, providerID string, expiration time.Time) error {
-	return DB.(*GormDBHandler).GetDB().Transaction(func(tx *gorm.DB) error {
-		// Fetch the slot to validate availability
-		var slot models.Slot
-		if err := tx.First(&slot, "id = ? AND status = ?", slotID, "Available").Error; err != nil {
+// RemoveAvailability deletes an availability window and its Available slots.
+// The reservations of the cancellations are cancelled first in the same
+// transaction, and the cancellations recorded are returned. It fails with
+// ErrAvailabilityReserved if the window has any other reservation.
+func (r *GormRepository) RemoveAvailability(ctx context.Context, availabilityID string, cancellations []models.Cancellation) ([]models.Cancellation, error) {
+	var recorded []models.Cancellation
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Lock the window so no slot of it is claimed meanwhile
+		var availability models.Availability
+		if err := lockRows(tx, false).First(&availability, "id = ?", availabilityID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return &NotFoundError{Kind: "availability", ID: availabilityID}
+			}
 			return err
 		}
 
-		// Create a reservation using the same slot ID
-		reservation := models.Reservation{
-			ID:                slot.ID, // Use the slot ID as the reservation ID
-			ClientID:          clientID,
-			ProviderID:        slot.ProviderID,
-			AvailabilityID:    slot.AvailabilityID,
-			ReservationExpiry: &expiration,
-			StartTime:         slot.StartTime,
-			EndTime:           slot.EndTime,
-			Status:            "Reserved",
+		var err error
+		recorded, err = cancelReservations(tx, cancellations)
+		if err != nil {
+			return err
 		}
-		if err := tx.Create(&reservation).Error; err != nil {
+
+		var reserved int64
+		if err := tx.Model(&models.Reservation{}).Where("availability_id = ?", availabilityID).Count(&reserved).Error; err != nil {
 			return err
 		}
+		if reserved > 0 {
+			return ErrAvailabilityReserved
+		}
 
-		// Remove the slot from the slots table
-		if err := tx.Delete(&slot).Error; err != nil {
+		if err := tx.Where("availability_id = ?", availabilityID).Delete(&models.Slot{}).Error; err != nil {
 			return err
 		}
+		return tx.Delete(&availability).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return recorded, nil
+}
+
+// UpdateProvider saves a provider's name, slot settings and time zone.
+func (r *GormRepository) UpdateProvider(ctx context.Context, provider models.Provider) error {
+	return r.db.WithContext(ctx).Model(&models.Provider{}).Where("id = ?", provider.ID).Updates(map[string]interface{}{
+		"name":                  provider.Name,
+		"slot_duration_minutes": provider.SlotDurationMinutes,
+		"buffer_before_minutes": provider.BufferBeforeMinutes,
+		"buffer_after_minutes":  provider.BufferAfterMinutes,
+		"min_lead_time_minutes": provider.MinLeadTimeMinutes,
+		"time_zone":             provider.TimeZone,
+	}).Error
+}
+
+// DeactivateProvider marks a provider inactive and expires the entries still
+// waiting on their waitlist in a single transaction.
+func (r *GormRepository) DeactivateProvider(ctx context.Context, providerID string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.Provider{}).Where("id = ?", providerID).Update("active", false)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return &NotFoundError{Kind: "provider", ID: providerID}
+		}
 
-		return nil
+		// Nobody waiting for an inactive provider can be served
+		return tx.Model(&models.WaitlistEntry{}).
+			Where("provider_id = ? AND status = ?", providerID, models.WaitlistWaiting).
+			Update("status", models.WaitlistExpired).Error
+	})
+}
+
+// ListProviders fetches the providers ordered by ID, leaving out inactive ones
+// unless includeInactive is set.
+func (r *GormRepository) ListProviders(ctx context.Context, includeInactive bool) ([]models.Provider, error) {
+	var providers []models.Provider
+	query := r.db.WithContext(ctx)
+	if !includeInactive {
+		query = query.Where("active = ?", true)
+	}
+	err := query.Order("id").Find(&providers).Error
+	return providers, err
+}
+
+// CreateClient saves a new client.
+func (r *GormRepository) CreateClient(ctx context.Context, client models.Client) error {
+	return duplicateError(r.db.WithContext(ctx).Create(&client).Error)
+}
+
+// GetClient fetches a client by ID.
+func (r *GormRepository) GetClient(ctx context.Context, clientID string) (models.Client, error) {
+	var client models.Client
+	err := r.first(ctx, &client, "id = ?", clientID)
+	return client, err
+}
+
+// UpdateClient saves a client's name, contact details, date of birth and
+// external identifier.
+func (r *GormRepository) UpdateClient(ctx context.Context, client models.Client) error {
+	err := r.db.WithContext(ctx).Model(&models.Client{}).Where("id = ?", client.ID).Updates(map[string]interface{}{
+		"name":          client.Name,
+		"email":         client.Email,
+		"phone":         client.Phone,
+		"date_of_birth": client.DateOfBirth,
+		"external_id":   client.ExternalID,
+	}).Error
+	return duplicateError(err)
+}
+
+// CreateAppointmentType saves an appointment type to a provider's catalog.
+func (r *GormRepository) CreateAppointmentType(ctx context.Context, appointmentType *models.AppointmentType) error {
+	return duplicateError(r.db.WithContext(ctx).Create(appointmentType).Error)
+}
+
+// GetAppointmentType fetches an appointment type from a provider's catalog, or
+// from any provider's when providerID is empty.
+func (r *GormRepository) GetAppointmentType(ctx context.Context, appointmentTypeID, providerID string) (models.AppointmentType, error) {
+	var appointmentType models.AppointmentType
+	query := r.db.WithContext(ctx).Where("id = ?", appointmentTypeID)
+	if providerID != "" {
+		query = query.Where("provider_id = ?", providerID)
+	}
+	err := query.First(&appointmentType).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return appointmentType, ErrNotFound
+	}
+	return appointmentType, err
+}
+
+// GetAppointmentTypes returns the appointment type catalog of a provider.
+func (r *GormRepository) GetAppointmentTypes(ctx context.Context, providerID string) ([]models.AppointmentType, error) {
+	var appointmentTypes []models.AppointmentType
+	err := r.db.WithContext(ctx).Where("provider_id = ?", providerID).Order("name").Find(&appointmentTypes).Error
+	return appointmentTypes, err
+}
+
+// DeleteAppointmentType removes an appointment type from its provider's catalog.
+// Existing reservations for it are kept.
+func (r *GormRepository) DeleteAppointmentType(ctx context.Context, appointmentTypeID string) error {
+	result := r.db.WithContext(ctx).Delete(&models.AppointmentType{}, "id = ?", appointmentTypeID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return &NotFoundError{Kind: "appointment type", ID: appointmentTypeID}
+	}
+	return nil
+}
+
+// CreateAvailabilityRule saves a recurring availability rule and its exceptions.
+func (r *GormRepository) CreateAvailabilityRule(ctx context.Context, rule *models.AvailabilityRule) error {
+	return duplicateError(r.db.WithContext(ctx).Create(rule).Error)
+}
+
+// GetAvailabilityRule fetches an availability rule and its exceptions.
+func (r *GormRepository) GetAvailabilityRule(ctx context.Context, ruleID string) (models.AvailabilityRule, error) {
+	var rule models.AvailabilityRule
+	err := r.db.WithContext(ctx).Preload("Exceptions").First(&rule, "id = ?", ruleID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return rule, ErrNotFound
+	}
+	return rule, err
+}
+
+// GetAvailabilityRules returns the availability rules of a provider, or of every
+// provider when providerID is empty.
+func (r *GormRepository) GetAvailabilityRules(ctx context.Context, providerID string) ([]models.AvailabilityRule, error) {
+	var rules []models.AvailabilityRule
+	query := r.db.WithContext(ctx).Preload("Exceptions")
+
+	if providerID != "" {
+		query = query.Where("provider_id = ?", providerID)
+	}
+
+	err := query.Find(&rules).Error
+	return rules, err
+}
+
+// DeleteAvailabilityRule deletes a rule together with the future Available slots
+// materialized from it. Windows that still hold reservations are kept.
+func (r *GormRepository) DeleteAvailabilityRule(ctx context.Context, ruleID string) error {
+	now := time.Now().UTC()
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Fetch the rule to validate it exists
+		var rule models.AvailabilityRule
+		if err := tx.First(&rule, "id = ?", ruleID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return &NotFoundError{Kind: "availability rule", ID: ruleID}
+			}
+			return err
+		}
+
+		// Remove the unreserved future slots and the windows left empty
+		if err := clearRuleWindows(tx, ruleID, now, time.Time{}); err != nil {
+			return err
+		}
+
+		// Remove the rule and its exceptions
+		if err := tx.Where("rule_id = ?", ruleID).Delete(&models.AvailabilityRuleException{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&rule).Error
 	})
 }
 
-func ConfirmReservation(reservationID string) error {
-	// Fetch the reservation
+// AddAvailabilityRuleException records a day a rule does not apply and removes
+// the Available slots materialized from the rule between dayStart and dayEnd,
+// in a single transaction. Windows that still hold reservations are kept.
+func (r *GormRepository) AddAvailabilityRuleException(ctx context.Context, exception models.AvailabilityRuleException, dayStart, dayEnd time.Time) error {
+	from := time.Now().UTC()
+	if dayStart.After(from) {
+		from = dayStart.UTC()
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Fetch the rule to validate it exists
+		var rule models.AvailabilityRule
+		if err := tx.First(&rule, "id = ?", exception.RuleID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return &NotFoundError{Kind: "availability rule", ID: exception.RuleID}
+			}
+			return err
+		}
+
+		if err := tx.Create(&exception).Error; err != nil {
+			return duplicateError(err)
+		}
+		return clearRuleWindows(tx, rule.ID, from, dayEnd.UTC())
+	})
+}
+
+// clearRuleWindows deletes the Available slots of a rule's windows that start
+// at or after from, and before to unless it is zero, and then the windows that
+// have nothing left in them.
+func clearRuleWindows(tx *gorm.DB, ruleID string, from, to time.Time) error {
+	windows := func() *gorm.DB {
+		query := tx.Model(&models.Availability{}).Where("rule_id = ? AND start_time >= ?", ruleID, from)
+		if !to.IsZero() {
+			query = query.Where("start_time < ?", to)
+		}
+		return query
+	}
+
+	// Remove the unreserved slots
+	if err := tx.Where("availability_id IN (?) AND status = ?", windows().Select("id"), "Available").Delete(&models.Slot{}).Error; err != nil {
+		return err
+	}
+
+	// Remove the windows that have nothing left in them
+	return tx.Where(
+		"id IN (?) AND id NOT IN (?) AND id NOT IN (?)", windows().Select("id"),
+		tx.Model(&models.Slot{}).Select("availability_id"),
+		tx.Model(&models.Reservation{}).Select("availability_id"),
+	).Delete(&models.Availability{}).Error
+}
+
+// GetAvailableSlot fetches a slot by ID if it is Available.
+func (r *GormRepository) GetAvailableSlot(ctx context.Context, slotID string) (models.Slot, error) {
+	var slot models.Slot
+	err := r.first(ctx, &slot, "id = ? AND status = ?", slotID, "Available")
+	return slot, err
+}
+
+// GetAvailableSlots returns the Available slots of a provider that start on the
+// day beginning at date, a midnight in the provider's or client's time zone.
+func (r *GormRepository) GetAvailableSlots(ctx context.Context, providerID string, date time.Time) ([]models.Slot, error) {
+	var slots []models.Slot
+	start, end := dayBounds(date)
+
+	err := r.db.WithContext(ctx).Where(
+		"provider_id = ? AND status = ? AND start_time >= ? AND start_time < ?",
+		providerID, "Available", start, end,
+	).Order("start_time").Find(&slots).Error
+
+	return slots, err
+}
+
+// CountAvailableSlots returns the number of future Available slots of each
+// provider that has any.
+func (r *GormRepository) CountAvailableSlots(ctx context.Context) (map[string]int, error) {
+	var rows []struct {
+		ProviderID string
+		Count      int
+	}
+	err := r.db.WithContext(ctx).Model(&models.Slot{}).Select("provider_id, COUNT(*) AS count").
+		Where("status = ? AND start_time > ?", "Available", time.Now().UTC()).
+		Group("provider_id").Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.ProviderID] = row.Count
+	}
+	return counts, nil
+}
+
+// dayBounds returns the start and end of the calendar day containing t in t's
+// location, in UTC to match how times are stored. The day is not always 24 hours
+// long when it contains a DST transition.
+func dayBounds(t time.Time) (time.Time, time.Time) {
+	start := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	return start.UTC(), start.AddDate(0, 0, 1).UTC()
+}
+
+// GetReservation fetches a reservation by ID.
+func (r *GormRepository) GetReservation(ctx context.Context, reservationID string) (models.Reservation, error) {
 	var reservation models.Reservation
-	result := DB.(*GormDBHandler).GetDB().First(&reservation, "id = ?", reservationID)
+	err := r.first(ctx, &reservation, "id = ?", reservationID)
+	return reservation, err
+}
+
+// ReserveSlot claims count consecutive Available slots starting at slotID for a
+// reservation in a single transaction. The reservation's slot, provider and times
+// are filled in from the claimed slots.
+func (r *GormRepository) ReserveSlot(ctx context.Context, reservation models.Reservation, slotID string, count int, maxGap time.Duration) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Remove the slots from the slots table
+		slots, err := claimSlots(tx, slotID, count, maxGap)
+		if err != nil {
+			return err
+		}
+
+		// Create a reservation holding the slots
+		holdSlots(&reservation, slots)
+		return tx.Create(&reservation).Error
+	})
+	return duplicateError(err)
+}
+
+// RescheduleReservation moves a reservation to count consecutive Available slots
+// starting at newSlotID in a single transaction. The old slots are returned to
+// the pool and the reservation keeps its ID, client and status. A hold that has
+// expired cannot be moved.
+func (r *GormRepository) RescheduleReservation(ctx context.Context, reservationID, newSlotID string, count int, maxGap time.Duration) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Fetch the reservation being moved
+		var reservation models.Reservation
+		if err := lockRows(tx, false).First(&reservation, "id = ?", reservationID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return &NotFoundError{Kind: "reservation", ID: reservationID}
+			}
+			return err
+		}
+		if holdExpired(reservation, time.Now()) {
+			return ErrHoldExpired
+		}
+
+		// Recreate the old slots with "Available" status in the slots table
+		if _, err := releaseSlots(tx, reservation); err != nil {
+			return err
+		}
+
+		// Remove the new slots from the slots table
+		slots, err := claimSlots(tx, newSlotID, count, maxGap)
+		if err != nil {
+			return err
+		}
+
+		// Point the reservation at the new slots
+		holdSlots(&reservation, slots)
+		err = tx.Model(&models.Reservation{}).Where("id = ?", reservation.ID).Updates(map[string]interface{}{
+			"slot_id":         reservation.SlotID,
+			"availability_id": reservation.AvailabilityID,
+			"provider_id":     reservation.ProviderID,
+			"start_time":      reservation.StartTime,
+			"end_time":        reservation.EndTime,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(&reservation.HeldSlots).Error
+	})
+}
+
+// claimSlots removes a run of count consecutive Available slots, starting at
+// startSlotID, from the slots table and returns them in order.
+func claimSlots(tx *gorm.DB, startSlotID string, count int, maxGap time.Duration) ([]models.Slot, error) {
+	// Fetch and lock the first slot to validate availability. A slot another
+	// transaction is claiming counts as not available
+	var first models.Slot
+	if err := lockRows(tx, true).First(&first, "id = ? AND status = ?", startSlotID, "Available").Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &SlotError{SlotID: startSlotID, Err: ErrSlotUnavailable}
+		}
+		return nil, err
+	}
+
+	// Fetch and lock the slots that follow it in the same availability window.
+	// Skipped slots leave a gap in the run, which fails the check below
+	var slots []models.Slot
+	err := lockRows(tx, true).Where("availability_id = ? AND status = ? AND start_time >= ?", first.AvailabilityID, "Available", first.StartTime).
+		Order("start_time").Limit(count).Find(&slots).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(slots) < count {
+		return nil, &SlotError{SlotID: startSlotID, Err: ErrNotEnoughSlots}
+	}
+	for i := 1; i < len(slots); i++ {
+		if !slots[i-1].Precedes(slots[i], maxGap) {
+			return nil, &SlotError{SlotID: startSlotID, Err: ErrNotEnoughSlots}
+		}
+	}
+
+	// Remove the slots, making sure nobody claimed them in the meantime
+	slotIDs := make([]string, 0, len(slots))
+	for _, slot := range slots {
+		slotIDs = append(slotIDs, slot.ID)
+	}
+	result := tx.Where("id IN ? AND status = ?", slotIDs, "Available").Delete(&models.Slot{})
 	if result.Error != nil {
-		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			return errors.New("reservation not found")
+		return nil, result.Error
+	}
+	if result.RowsAffected != int64(len(slots)) {
+		return nil, &SlotError{SlotID: startSlotID, Err: ErrSlotUnavailable}
+	}
+
+	return slots, nil
+}
+
+// holdSlots points a reservation at the slots it holds, from the start of the
+// first slot to the end of the last one.
+func holdSlots(reservation *models.Reservation, slots []models.Slot) {
+	first, last := slots[0], slots[len(slots)-1]
+	reservation.SlotID = first.ID
+	reservation.ProviderID = first.ProviderID
+	reservation.AvailabilityID = first.AvailabilityID
+	reservation.StartTime = first.StartTime
+	reservation.EndTime = last.EndTime
+
+	reservation.HeldSlots = nil
+	for _, slot := range slots {
+		reservation.HeldSlots = append(reservation.HeldSlots, models.ReservationSlot{
+			ReservationID:  reservation.ID,
+			SlotID:         slot.ID,
+			AvailabilityID: slot.AvailabilityID,
+			ProviderID:     slot.ProviderID,
+			StartTime:      slot.StartTime,
+			EndTime:        slot.EndTime,
+		})
+	}
+}
+
+func (r *GormRepository) ConfirmReservation(ctx context.Context, reservationID string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Fetch the reservation, keeping the cleanup from releasing it meanwhile
+		reservation, err := lockReservation(tx, reservationID)
+		if err != nil {
+			return err
 		}
-		return result.Error
+
+		// Check if the reservation is already confirmed or its hold has expired
+		if err := checkHold(reservation, time.Now()); err != nil {
+			return err
+		}
+
+		// Update the reservation status to Confirmed
+		return tx.Model(&models.Reservation{}).Where("id = ?", reservationID).
+			Update("status", "Confirmed").Error
+	})
+}
+
+// ExtendHold pushes the expiry of an unconfirmed reservation back by
+// extension, at most maxExtensions times, and returns the updated reservation.
+func (r *GormRepository) ExtendHold(ctx context.Context, reservationID string, extension time.Duration, maxExtensions int) (models.Reservation, error) {
+	var reservation models.Reservation
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		reservation, err = lockReservation(tx, reservationID)
+		if err != nil {
+			return err
+		}
+		if err := checkHold(reservation, time.Now()); err != nil {
+			return err
+		}
+		if reservation.HoldExtensions >= maxExtensions {
+			return ErrHoldExtensionLimit
+		}
+
+		expiry := time.Now()
+		if reservation.ReservationExpiry != nil {
+			expiry = *reservation.ReservationExpiry
+		}
+		expiry = expiry.Add(extension).UTC()
+		reservation.ReservationExpiry = &expiry
+		reservation.HoldExtensions++
+		return tx.Model(&models.Reservation{}).Where("id = ?", reservationID).Updates(map[string]interface{}{
+			"reservation_expiry": expiry,
+			"hold_extensions":    reservation.HoldExtensions,
+		}).Error
+	})
+	return reservation, err
+}
+
+// ReplaceHoldToken stores the hash of a new hold token for an unconfirmed
+// reservation whose hold has not expired, and returns the reservation. The old
+// token stops working.
+func (r *GormRepository) ReplaceHoldToken(ctx context.Context, reservationID, tokenHash string) (models.Reservation, error) {
+	var reservation models.Reservation
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		reservation, err = lockReservation(tx, reservationID)
+		if err != nil {
+			return err
+		}
+		if err := checkHold(reservation, time.Now()); err != nil {
+			return err
+		}
+
+		reservation.HoldTokenHash = tokenHash
+		return tx.Model(&models.Reservation{}).Where("id = ?", reservationID).Update("hold_token_hash", tokenHash).Error
+	})
+	return reservation, err
+}
+
+// lockReservation fetches a reservation and locks it until the transaction
+// ends.
+func lockReservation(tx *gorm.DB, reservationID string) (models.Reservation, error) {
+	var reservation models.Reservation
+	err := lockRows(tx, false).First(&reservation, "id = ?", reservationID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return reservation, &NotFoundError{Kind: "reservation", ID: reservationID}
 	}
+	return reservation, err
+}
 
-	// Check if the reservation is already confirmed
+// checkHold fails unless a reservation is an unconfirmed hold that has not
+// expired at now.
+func checkHold(reservation models.Reservation, now time.Time) error {
 	if reservation.Status == "Confirmed" {
-		return errors.New("reservation is already confirmed")
+		return ErrAlreadyConfirmed
+	}
+	if holdExpired(reservation, now) {
+		return ErrHoldExpired
+	}
+	return nil
+}
+
+// holdExpired reports whether a reservation is an unconfirmed hold that has
+// expired at now but not been released yet.
+func holdExpired(reservation models.Reservation, now time.Time) bool {
+	return reservation.Status != "Confirmed" && reservation.ReservationExpiry != nil && !reservation.ReservationExpiry.After(now)
+}
+
+// CancelReservation deletes a reservation, records who cancelled it and why, and
+// returns its slots to the pool as Available in a single transaction.
+func (r *GormRepository) CancelReservation(ctx context.Context, cancellation models.Cancellation) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return cancelReservation(tx, &cancellation)
+	})
+}
+
+// cancelReservation deletes a reservation, records the cancellation with the
+// reservation's details filled in and returns its slots to the pool.
+func cancelReservation(tx *gorm.DB, cancellation *models.Cancellation) error {
+	// Fetch the reservation being cancelled
+	var reservation models.Reservation
+	if err := lockRows(tx, false).First(&reservation, "id = ?", cancellation.ReservationID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &NotFoundError{Kind: "reservation", ID: cancellation.ReservationID}
+		}
+		return err
+	}
+
+	// Recreate the slots with "Available" status in the slots table
+	slots, err := releaseSlots(tx, reservation)
+	if err != nil {
+		return err
+	}
+
+	// Keep a record of the cancelled reservation
+	cancellation.SlotID = slots[0].ID
+	cancellation.ClientID = reservation.ClientID
+	cancellation.ProviderID = reservation.ProviderID
+	cancellation.StartTime = reservation.StartTime
+	cancellation.EndTime = reservation.EndTime
+	if err := tx.Create(cancellation).Error; err != nil {
+		return err
 	}
 
-	// Update the reservation status to Confirmed
-	return DB.(*GormDBHandler).GetDB().Model(&models.Reservation{}).Where("id = ?", reservationID).
-		Update("status", "Confirmed").Error
+	// Delete the reservation
+	return tx.Delete(&models.Reservation{}, "id = ?", reservation.ID).Error
 }
 
-func CleanupExpiredReservations() error {
-	now := time.Now()
-	log.Println("Checking for expired reservations...")
+// cancelReservations cancels the reservations of an availability change and
+// declines the waitlist offers they held. Reservations already cancelled or
+// released are skipped, and the cancellations recorded are returned.
+func cancelReservations(tx *gorm.DB, cancellations []models.Cancellation) ([]models.Cancellation, error) {
+	var recorded []models.Cancellation
+	for _, cancellation := range cancellations {
+		err := cancelReservation(tx, &cancellation)
+		var notFound *NotFoundError
+		if errors.As(err, &notFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := closeWaitlistOffer(tx, cancellation.ReservationID, models.WaitlistDeclined); err != nil {
+			return nil, err
+		}
+		recorded = append(recorded, cancellation)
+	}
+	return recorded, nil
+}
 
-	return DB.(*GormDBHandler).GetDB().Transaction(func(tx *gorm.DB) error {
-		// Fetch expired reservations
+// releaseSlots recreates the slots held by a reservation with "Available"
+// status in the slots table and returns them in order.
+func releaseSlots(tx *gorm.DB, reservation models.Reservation) ([]models.Slot, error) {
+	var held []models.ReservationSlot
+	if err := tx.Where("reservation_id = ?", reservation.ID).Order("start_time").Find(&held).Error; err != nil {
+		return nil, err
+	}
+
+	if len(held) == 0 {
+		// Older reservations only recorded a single slot, or reused its ID
+		slotID := reservation.SlotID
+		if slotID == "" {
+			slotID = reservation.ID
+		}
+		held = append(held, models.ReservationSlot{
+			SlotID:         slotID,
+			AvailabilityID: reservation.AvailabilityID,
+			ProviderID:     reservation.ProviderID,
+			StartTime:      reservation.StartTime,
+			EndTime:        reservation.EndTime,
+		})
+	}
+
+	slots := make([]models.Slot, 0, len(held))
+	for _, h := range held {
+		slots = append(slots, models.Slot{
+			ID:             h.SlotID,
+			AvailabilityID: h.AvailabilityID,
+			ProviderID:     h.ProviderID,
+			StartTime:      h.StartTime,
+			EndTime:        h.EndTime,
+			Status:         "Available",
+		})
+	}
+	if err := tx.Create(&slots).Error; err != nil {
+		return nil, err
+	}
+
+	if err := tx.Delete(&models.ReservationSlot{}, "reservation_id = ?", reservation.ID).Error; err != nil {
+		return nil, err
+	}
+	return slots, nil
+}
+
+// logReleased logs an expired hold whose slots went back to the pool.
+func logReleased(ctx context.Context, reservation models.Reservation) {
+	slog.InfoContext(ctx, "Released expired reservation",
+		"reservation_id", reservation.ID,
+		"provider_id", reservation.ProviderID,
+		"client_id", reservation.ClientID,
+		"slot_id", reservation.SlotID,
+		"start_time", reservation.StartTime,
+		"expired_at", reservation.ReservationExpiry,
+	)
+}
+
+// CleanupExpiredReservations returns the slots of expired holds to the pool and
+// returns the reservations that expired.
+func (r *GormRepository) CleanupExpiredReservations(ctx context.Context) ([]models.Reservation, error) {
+	now := time.Now().UTC()
+	slog.DebugContext(ctx, "Checking for expired reservations")
+
+	var released []models.Reservation
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Fetch expired reservations, leaving those another replica is already
+		// handling
 		var expiredReservations []models.Reservation
-		if err := tx.Where("status = ? AND reservation_expiry < ?", "Reserved", now).Find(&expiredReservations).Error; err != nil {
+		if err := lockRows(tx, true).Where("status = ? AND reservation_expiry < ?", "Reserved", now).Find(&expiredReservations).Error; err != nil {
 			return err
 		}
 
 		// Iterate over expired reservations
 		for _, reservation := range expiredReservations {
-			// Recreate the slot with "Available" status in the slots table
-			newSlot := models.Slot{
-				ID:             reservation.ID, // Use the same ID
-				AvailabilityID: reservation.AvailabilityID,
-				ProviderID:     reservation.ProviderID,
-				StartTime:      reservation.StartTime,
-				EndTime:        reservation.EndTime,
-				Status:         "Available",
-			}
-
-			if err := tx.Create(&newSlot).Error; err != nil {
+			// Recreate the slots with "Available" status in the slots table
+			if _, err := releaseSlots(tx, reservation); err != nil {
 				return err
 			}
 
@@ -137,37 +883,218 @@ func CleanupExpiredReservations() error {
 			}
 		}
 
-		log.Printf("Expired reservations cleaned up: %d records processed", len(expiredReservations))
+		released = expiredReservations
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Log once the transaction has committed, so only released holds are logged
+	for _, reservation := range released {
+		logReleased(ctx, reservation)
+	}
+	if len(released) > 0 {
+		slog.InfoContext(ctx, "Expired reservations cleaned up", "count", len(released))
+	}
+	return released, nil
 }
 
-func GetReservationsByProvider(providerID string, date *time.Time) ([]models.Reservation, error) {
+func (r *GormRepository) GetReservationsByProvider(ctx context.Context, providerID string, date *time.Time) ([]models.Reservation, error) {
 	var reservations []models.Reservation
-	query := DB.(*GormDBHandler).GetDB().Where("provider_id = ?", providerID)
+	query := r.db.WithContext(ctx).Where("provider_id = ?", providerID)
 
 	if date != nil {
-		start := date.Truncate(24 * time.Hour)
-		end := start.Add(24 * time.Hour)
-		query = query.Where("slot_id IN (?)", DB.(*GormDBHandler).GetDB().
-			Model(&models.Slot{}).Select("id").Where("start_time BETWEEN ? AND ?", start, end))
+		start, end := dayBounds(*date)
+		query = query.Where("start_time >= ? AND start_time < ?", start, end)
 	}
 
-	err := query.Preload("Slot").Find(&reservations).Error
+	err := query.Preload("Slot").Order("start_time").Find(&reservations).Error
 	return reservations, err
 }
 
-func GetReservationsByClient(clientID string, date *time.Time) ([]models.Reservation, error) {
+// GetReservationsByAvailability fetches the reservations held in an
+// availability window, ordered by start time.
+func (r *GormRepository) GetReservationsByAvailability(ctx context.Context, availabilityID string) ([]models.Reservation, error) {
 	var reservations []models.Reservation
-	query := DB.(*GormDBHandler).GetDB().Where("client_id = ?", clientID)
+	err := r.db.WithContext(ctx).Where("availability_id = ?", availabilityID).Order("start_time").Find(&reservations).Error
+	return reservations, err
+}
+
+func (r *GormRepository) GetReservationsByClient(ctx context.Context, clientID string, date *time.Time) ([]models.Reservation, error) {
+	var reservations []models.Reservation
+	query := r.db.WithContext(ctx).Where("client_id = ?", clientID)
 
 	if date != nil {
-		start := date.Truncate(24 * time.Hour)
-		end := start.Add(24 * time.Hour)
-		query = query.Where("slot_id IN (?)", DB.(*GormDBHandler).GetDB().
-			Model(&models.Slot{}).Select("id").Where("start_time BETWEEN ? AND ?", start, end))
+		start, end := dayBounds(*date)
+		query = query.Where("start_time >= ? AND start_time < ?", start, end)
 	}
 
-	err := query.Preload("Slot").Find(&reservations).Error
+	err := query.Preload("Slot").Order("start_time").Find(&reservations).Error
 	return reservations, err
 }
+
+// CreateWaitlistEntry saves a new waitlist entry.
+func (r *GormRepository) CreateWaitlistEntry(ctx context.Context, entry models.WaitlistEntry) error {
+	return duplicateError(r.db.WithContext(ctx).Create(&entry).Error)
+}
+
+// GetWaitlistEntry fetches a waitlist entry by ID.
+func (r *GormRepository) GetWaitlistEntry(ctx context.Context, entryID string) (models.WaitlistEntry, error) {
+	var entry models.WaitlistEntry
+	err := r.first(ctx, &entry, "id = ?", entryID)
+	return entry, err
+}
+
+// GetWaitlistEntriesByClient fetches a client's waitlist entries, oldest
+// first.
+func (r *GormRepository) GetWaitlistEntriesByClient(ctx context.Context, clientID string) ([]models.WaitlistEntry, error) {
+	var entries []models.WaitlistEntry
+	err := r.db.WithContext(ctx).Where("client_id = ?", clientID).Order("created_at, id").Find(&entries).Error
+	return entries, err
+}
+
+// GetWaitingEntries fetches the entries still waiting for a provider's slots,
+// in the order they joined.
+func (r *GormRepository) GetWaitingEntries(ctx context.Context, providerID string) ([]models.WaitlistEntry, error) {
+	var entries []models.WaitlistEntry
+	err := r.db.WithContext(ctx).Where("provider_id = ? AND status = ?", providerID, models.WaitlistWaiting).
+		Order("created_at, id").Find(&entries).Error
+	return entries, err
+}
+
+// OfferWaitlistSlot holds the slots starting at slotID for a waiting entry's
+// client and marks the entry Offered, atomically. An entry that is no longer
+// waiting returns ErrNotWaiting.
+func (r *GormRepository) OfferWaitlistSlot(ctx context.Context, entryID string, reservation models.Reservation, slotID string, count int, maxGap time.Duration) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Take the entry, unless another offer already has
+		result := tx.Model(&models.WaitlistEntry{}).Where("id = ? AND status = ?", entryID, models.WaitlistWaiting).Updates(map[string]interface{}{
+			"status":         models.WaitlistOffered,
+			"reservation_id": reservation.ID,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrNotWaiting
+		}
+
+		// Remove the slots from the slots table and hold them for the client
+		slots, err := claimSlots(tx, slotID, count, maxGap)
+		if err != nil {
+			return err
+		}
+		holdSlots(&reservation, slots)
+		return tx.Create(&reservation).Error
+	})
+	return duplicateError(err)
+}
+
+// AcceptWaitlistOffer confirms the reservation offered to a waitlist entry and
+// marks the entry Accepted, unless the offer has expired.
+func (r *GormRepository) AcceptWaitlistOffer(ctx context.Context, entryID string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var entry models.WaitlistEntry
+		if err := lockRows(tx, false).First(&entry, "id = ?", entryID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return &NotFoundError{Kind: "waitlist entry", ID: entryID}
+			}
+			return err
+		}
+		if entry.Status != models.WaitlistOffered {
+			return ErrNoOffer
+		}
+
+		// Confirm the offered hold, keeping the cleanup from releasing it
+		reservation, err := lockReservation(tx, entry.ReservationID)
+		if errors.Is(err, ErrNotFound) {
+			return ErrNoOffer
+		}
+		if err != nil {
+			return err
+		}
+		if err := checkHold(reservation, time.Now()); err != nil {
+			return err
+		}
+		if err := tx.Model(&models.Reservation{}).Where("id = ?", reservation.ID).Update("status", "Confirmed").Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&models.WaitlistEntry{}).Where("id = ?", entryID).Update("status", models.WaitlistAccepted).Error
+	})
+}
+
+// CloseWaitlistOffer gives the waitlist entry holding a reservation as an open
+// offer its final status, once the reservation has been released. Other
+// reservations are ignored.
+func (r *GormRepository) CloseWaitlistOffer(ctx context.Context, reservationID, status string) error {
+	return closeWaitlistOffer(r.db.WithContext(ctx), reservationID, status)
+}
+
+// closeWaitlistOffer gives the entry holding a reservation as an open offer its
+// final status.
+func closeWaitlistOffer(tx *gorm.DB, reservationID, status string) error {
+	return tx.Model(&models.WaitlistEntry{}).
+		Where("reservation_id = ? AND status = ?", reservationID, models.WaitlistOffered).
+		Update("status", status).Error
+}
+
+// ExpireWaitlistEntries expires a provider's waiting entries whose last day,
+// in the provider's time zone, is before the given day, and returns how many
+// it expired.
+func (r *GormRepository) ExpireWaitlistEntries(ctx context.Context, providerID, day string) (int, error) {
+	result := r.db.WithContext(ctx).Model(&models.WaitlistEntry{}).
+		Where("provider_id = ? AND status = ? AND end_date < ?", providerID, models.WaitlistWaiting, day).
+		Update("status", models.WaitlistExpired)
+	return int(result.RowsAffected), result.Error
+}
+
+// CreateIdempotencyKey records a request made with an idempotency key. A key
+// whose record has expired is reused, and one that has not returns
+// ErrAlreadyExists.
+func (r *GormRepository) CreateIdempotencyKey(ctx context.Context, key models.IdempotencyKey) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&models.IdempotencyKey{}, "id = ? AND expires_at <= ?", key.ID, time.Now().UTC()).Error; err != nil {
+			return err
+		}
+		return tx.Create(&key).Error
+	})
+	return duplicateError(err)
+}
+
+// GetIdempotencyKey fetches the record of an idempotency key.
+func (r *GormRepository) GetIdempotencyKey(ctx context.Context, keyID string) (models.IdempotencyKey, error) {
+	var key models.IdempotencyKey
+	err := r.first(ctx, &key, "id = ?", keyID)
+	return key, err
+}
+
+// SaveIdempotentResponse stores the response to a request made with an
+// idempotency key, to be replayed until expiresAt.
+func (r *GormRepository) SaveIdempotentResponse(ctx context.Context, keyID string, response []byte, expiresAt time.Time) error {
+	result := r.db.WithContext(ctx).Model(&models.IdempotencyKey{}).Where("id = ?", keyID).Updates(map[string]interface{}{
+		"response":   response,
+		"expires_at": expiresAt.UTC(),
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// DeleteIdempotencyKey deletes the record of an idempotency key, so that the
+// key can be used again.
+func (r *GormRepository) DeleteIdempotencyKey(ctx context.Context, keyID string) error {
+	return r.db.WithContext(ctx).Delete(&models.IdempotencyKey{}, "id = ?", keyID).Error
+}
+
+// DeleteExpiredIdempotencyKeys deletes the records of expired idempotency keys
+// and returns how many there were.
+func (r *GormRepository) DeleteExpiredIdempotencyKeys(ctx context.Context) (int, error) {
+	result := r.db.WithContext(ctx).Delete(&models.IdempotencyKey{}, "expires_at <= ?", time.Now().UTC())
+	return int(result.RowsAffected), result.Error
+}
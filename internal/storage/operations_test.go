@@ -0,0 +1,470 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+
+	"github.com/manueldelreal/health-reservation-system/internal/models"
+)
+
+// seedSlots creates a provider with an availability window tomorrow split into
+// count consecutive 30-minute slots, and returns the slots in order.
+func seedSlots(t *testing.T, repo Repository, count int) []models.Slot {
+	t.Helper()
+	ctx := context.Background()
+
+	provider := models.Provider{ID: "provider-1", Name: "Dr. Test", Active: true}
+	if err := repo.CreateProvider(ctx, provider); err != nil {
+		t.Fatalf("CreateProvider: %v", err)
+	}
+
+	start := time.Now().UTC().Truncate(24 * time.Hour).Add(33 * time.Hour)
+	availability := models.Availability{
+		ID:         ulid.Make().String(),
+		ProviderID: provider.ID,
+		StartTime:  start,
+		EndTime:    start.Add(time.Duration(count) * 30 * time.Minute),
+	}
+	var slots []models.Slot
+	for i := 0; i < count; i++ {
+		slotStart := start.Add(time.Duration(i) * 30 * time.Minute)
+		slots = append(slots, models.Slot{
+			ID:             ulid.Make().String(),
+			AvailabilityID: availability.ID,
+			StartTime:      slotStart,
+			EndTime:        slotStart.Add(30 * time.Minute),
+			Status:         "Available",
+		})
+	}
+	err := repo.AddAvailabilityAndSlots(ctx, provider.ID, nil, []models.Availability{availability}, slots)
+	if err != nil {
+		t.Fatalf("AddAvailabilityAndSlots: %v", err)
+	}
+	return slots
+}
+
+// newHold returns an unconfirmed reservation for a client, expiring in an hour.
+func newHold(clientID string) models.Reservation {
+	expiry := time.Now().UTC().Add(time.Hour)
+	return models.Reservation{
+		ID:                ulid.Make().String(),
+		ClientID:          clientID,
+		ReservationExpiry: &expiry,
+		Status:            "Reserved",
+	}
+}
+
+// reserveConcurrently has one client per start slot reserve count slots from
+// it at the same time, and returns the number of successful reservations. Every
+// failure must be a SlotError.
+func reserveConcurrently(t *testing.T, repo Repository, startSlotIDs []string, count int) int {
+	t.Helper()
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	errs := make([]error, len(startSlotIDs))
+	for i, slotID := range startSlotIDs {
+		wg.Add(1)
+		go func(i int, slotID string) {
+			defer wg.Done()
+			<-start
+			errs[i] = repo.ReserveSlot(ctx, newHold(fmt.Sprintf("client-%d", i)), slotID, count, 0)
+		}(i, slotID)
+	}
+	close(start)
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		var slotErr *SlotError
+		switch {
+		case err == nil:
+			succeeded++
+		case errors.As(err, &slotErr):
+		default:
+			t.Errorf("ReserveSlot: unexpected error %v", err)
+		}
+	}
+	return succeeded
+}
+
+func TestReserveSlotConcurrentlyOnPostgres(t *testing.T) {
+	repo := NewGormRepository(openTestPostgres(t))
+	ctx := context.Background()
+	slots := seedSlots(t, repo, 1)
+
+	const clients = 20
+	startSlotIDs := make([]string, clients)
+	for i := range startSlotIDs {
+		startSlotIDs[i] = slots[0].ID
+	}
+	if got := reserveConcurrently(t, repo, startSlotIDs, 1); got != 1 {
+		t.Fatalf("%d of %d concurrent reservations of the same slot succeeded, want 1", got, clients)
+	}
+
+	// The slot is held by exactly one reservation and gone from the pool
+	reservations, err := repo.GetReservationsByProvider(ctx, "provider-1", nil)
+	if err != nil {
+		t.Fatalf("GetReservationsByProvider: %v", err)
+	}
+	if len(reservations) != 1 || reservations[0].SlotID != slots[0].ID {
+		t.Fatalf("got reservations %+v, want one holding slot %s", reservations, slots[0].ID)
+	}
+	if _, err := repo.GetAvailableSlot(ctx, slots[0].ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("GetAvailableSlot of the reserved slot: got %v, want ErrNotFound", err)
+	}
+}
+
+func TestReserveOverlappingRunsConcurrentlyOnPostgres(t *testing.T) {
+	repo := NewGormRepository(openTestPostgres(t))
+	ctx := context.Background()
+	slots := seedSlots(t, repo, 3)
+
+	// Runs of two slots from the first and the second slot share the second,
+	// so only one of them can be booked
+	var startSlotIDs []string
+	for i := 0; i < 10; i++ {
+		startSlotIDs = append(startSlotIDs, slots[0].ID, slots[1].ID)
+	}
+	if got := reserveConcurrently(t, repo, startSlotIDs, 2); got != 1 {
+		t.Fatalf("%d concurrent reservations of overlapping runs succeeded, want 1", got)
+	}
+
+	// No slot is held twice
+	reservations, err := repo.GetReservationsByProvider(ctx, "provider-1", nil)
+	if err != nil {
+		t.Fatalf("GetReservationsByProvider: %v", err)
+	}
+	held := 2 * len(reservations)
+	available, err := repo.GetAvailableSlots(ctx, "provider-1", slots[0].StartTime)
+	if err != nil {
+		t.Fatalf("GetAvailableSlots: %v", err)
+	}
+	if held+len(available) != len(slots) {
+		t.Fatalf("%d slots held and %d available, want %d in total", held, len(available), len(slots))
+	}
+}
+
+func TestReserveSlotClaimsConsecutiveSlots(t *testing.T) {
+	repo := NewGormRepository(openTestSQLite(t))
+	ctx := context.Background()
+	slots := seedSlots(t, repo, 4)
+
+	if err := repo.ReserveSlot(ctx, newHold("client-1"), slots[1].ID, 1, 0); err != nil {
+		t.Fatalf("ReserveSlot: %v", err)
+	}
+
+	// The run from the first slot is broken by the held one
+	err := repo.ReserveSlot(ctx, newHold("client-2"), slots[0].ID, 2, 0)
+	if !errors.Is(err, ErrNotEnoughSlots) {
+		t.Fatalf("ReserveSlot across a held slot: got %v, want ErrNotEnoughSlots", err)
+	}
+	if _, err := repo.GetAvailableSlot(ctx, slots[0].ID); err != nil {
+		t.Fatalf("first slot after a failed claim: %v", err)
+	}
+
+	held := newHold("client-2")
+	if err := repo.ReserveSlot(ctx, held, slots[2].ID, 2, 0); err != nil {
+		t.Fatalf("ReserveSlot of two slots: %v", err)
+	}
+	reservation, err := repo.GetReservation(ctx, held.ID)
+	if err != nil {
+		t.Fatalf("GetReservation: %v", err)
+	}
+	if !reservation.StartTime.Equal(slots[2].StartTime) || !reservation.EndTime.Equal(slots[3].EndTime) {
+		t.Fatalf("got reservation from %v to %v, want %v to %v", reservation.StartTime, reservation.EndTime, slots[2].StartTime, slots[3].EndTime)
+	}
+	for _, slot := range slots[2:] {
+		if _, err := repo.GetAvailableSlot(ctx, slot.ID); !errors.Is(err, ErrNotFound) {
+			t.Fatalf("slot %s after claiming it: got %v, want it held", slot.ID, err)
+		}
+	}
+}
+
+func TestRescheduleReservationRollsBack(t *testing.T) {
+	repo := NewGormRepository(openTestSQLite(t))
+	ctx := context.Background()
+	slots := seedSlots(t, repo, 3)
+
+	held := newHold("client-1")
+	if err := repo.ReserveSlot(ctx, held, slots[0].ID, 1, 0); err != nil {
+		t.Fatalf("ReserveSlot: %v", err)
+	}
+	if err := repo.ReserveSlot(ctx, newHold("client-2"), slots[1].ID, 1, 0); err != nil {
+		t.Fatalf("ReserveSlot: %v", err)
+	}
+
+	// Moving onto a held slot fails and leaves the old slot held
+	err := repo.RescheduleReservation(ctx, held.ID, slots[1].ID, 1, 0)
+	if !errors.Is(err, ErrSlotUnavailable) {
+		t.Fatalf("RescheduleReservation onto a held slot: got %v, want ErrSlotUnavailable", err)
+	}
+	if _, err := repo.GetAvailableSlot(ctx, slots[0].ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("old slot after a failed reschedule: got %v, want it still held", err)
+	}
+
+	if err := repo.RescheduleReservation(ctx, held.ID, slots[2].ID, 1, 0); err != nil {
+		t.Fatalf("RescheduleReservation: %v", err)
+	}
+	reservation, err := repo.GetReservation(ctx, held.ID)
+	if err != nil {
+		t.Fatalf("GetReservation: %v", err)
+	}
+	if reservation.SlotID != slots[2].ID || reservation.ClientID != "client-1" {
+		t.Fatalf("got reservation of %s on slot %s, want client-1 on %s", reservation.ClientID, reservation.SlotID, slots[2].ID)
+	}
+	if _, err := repo.GetAvailableSlot(ctx, slots[0].ID); err != nil {
+		t.Fatalf("old slot after rescheduling: %v", err)
+	}
+}
+
+func TestRescheduleReservationRejectsExpiredHold(t *testing.T) {
+	repo := NewGormRepository(openTestSQLite(t))
+	ctx := context.Background()
+	slots := seedSlots(t, repo, 2)
+
+	held := newHold("client-1")
+	expired := time.Now().UTC().Add(-time.Minute)
+	held.ReservationExpiry = &expired
+	if err := repo.ReserveSlot(ctx, held, slots[0].ID, 1, 0); err != nil {
+		t.Fatalf("ReserveSlot: %v", err)
+	}
+	if err := repo.RescheduleReservation(ctx, held.ID, slots[1].ID, 1, 0); !errors.Is(err, ErrHoldExpired) {
+		t.Fatalf("RescheduleReservation of an expired hold: got %v, want ErrHoldExpired", err)
+	}
+}
+
+func TestRemoveAvailabilityCancelsInOneTransaction(t *testing.T) {
+	repo := NewGormRepository(openTestSQLite(t))
+	ctx := context.Background()
+	slots := seedSlots(t, repo, 2)
+
+	first, second := newHold("client-1"), newHold("client-2")
+	for i, hold := range []models.Reservation{first, second} {
+		if err := repo.ReserveSlot(ctx, hold, slots[i].ID, 1, 0); err != nil {
+			t.Fatalf("ReserveSlot: %v", err)
+		}
+	}
+	offer := models.WaitlistEntry{
+		ID:            ulid.Make().String(),
+		ClientID:      "client-1",
+		ProviderID:    "provider-1",
+		StartDate:     "2000-01-01",
+		EndDate:       "2100-01-01",
+		Status:        models.WaitlistOffered,
+		ReservationID: first.ID,
+		CreatedAt:     time.Now().UTC(),
+	}
+	if err := repo.CreateWaitlistEntry(ctx, offer); err != nil {
+		t.Fatalf("CreateWaitlistEntry: %v", err)
+	}
+	cancel := func(reservationID string) models.Cancellation {
+		return models.Cancellation{ID: ulid.Make().String(), ReservationID: reservationID, CancelledBy: "provider-1", CancelledAt: time.Now().UTC()}
+	}
+
+	// The second reservation is not being cancelled, so nothing changes
+	_, err := repo.RemoveAvailability(ctx, slots[0].AvailabilityID, []models.Cancellation{cancel(first.ID)})
+	if !errors.Is(err, ErrAvailabilityReserved) {
+		t.Fatalf("RemoveAvailability with a reservation left: got %v, want ErrAvailabilityReserved", err)
+	}
+	if _, err := repo.GetReservation(ctx, first.ID); err != nil {
+		t.Fatalf("first reservation after a failed removal: %v", err)
+	}
+	if entry, err := repo.GetWaitlistEntry(ctx, offer.ID); err != nil || entry.Status != models.WaitlistOffered {
+		t.Fatalf("waitlist offer after a failed removal: got %v, %v, want it still offered", entry.Status, err)
+	}
+
+	recorded, err := repo.RemoveAvailability(ctx, slots[0].AvailabilityID, []models.Cancellation{cancel(first.ID), cancel(second.ID)})
+	if err != nil {
+		t.Fatalf("RemoveAvailability: %v", err)
+	}
+	if len(recorded) != 2 || recorded[0].ClientID != "client-1" || recorded[1].ClientID != "client-2" {
+		t.Fatalf("got cancellations %v, want both reservations", recorded)
+	}
+	if _, err := repo.GetReservation(ctx, second.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("second reservation after the removal: got %v, want ErrNotFound", err)
+	}
+	if entry, err := repo.GetWaitlistEntry(ctx, offer.ID); err != nil || entry.Status != models.WaitlistDeclined {
+		t.Fatalf("waitlist offer after the removal: got %v, %v, want it declined", entry.Status, err)
+	}
+	if _, err := repo.GetAvailableSlot(ctx, slots[0].ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("slot after the removal: got %v, want ErrNotFound", err)
+	}
+}
+
+func TestOfferWaitlistSlot(t *testing.T) {
+	repo := NewGormRepository(openTestSQLite(t))
+	ctx := context.Background()
+	slots := seedSlots(t, repo, 1)
+
+	waiting := func(clientID, endDate string) models.WaitlistEntry {
+		entry := models.WaitlistEntry{
+			ID:         ulid.Make().String(),
+			ClientID:   clientID,
+			ProviderID: "provider-1",
+			StartDate:  "2000-01-01",
+			EndDate:    endDate,
+			Status:     models.WaitlistWaiting,
+			CreatedAt:  time.Now().UTC(),
+		}
+		if err := repo.CreateWaitlistEntry(ctx, entry); err != nil {
+			t.Fatalf("CreateWaitlistEntry: %v", err)
+		}
+		return entry
+	}
+	first, second := waiting("client-1", "2100-01-01"), waiting("client-2", "2100-01-01")
+	stale := waiting("client-3", "2000-01-02")
+
+	offer := newHold("client-1")
+	if err := repo.OfferWaitlistSlot(ctx, first.ID, offer, slots[0].ID, 1, 0); err != nil {
+		t.Fatalf("OfferWaitlistSlot: %v", err)
+	}
+	if err := repo.OfferWaitlistSlot(ctx, first.ID, newHold("client-1"), slots[0].ID, 1, 0); !errors.Is(err, ErrNotWaiting) {
+		t.Fatalf("OfferWaitlistSlot to an entry with an offer: got %v, want ErrNotWaiting", err)
+	}
+	if err := repo.OfferWaitlistSlot(ctx, second.ID, newHold("client-2"), slots[0].ID, 1, 0); !errors.Is(err, ErrSlotUnavailable) {
+		t.Fatalf("OfferWaitlistSlot of an offered slot: got %v, want ErrSlotUnavailable", err)
+	}
+	if entry, err := repo.GetWaitlistEntry(ctx, second.ID); err != nil || entry.Status != models.WaitlistWaiting {
+		t.Fatalf("second entry after a failed offer: got %v, %v, want it waiting", entry.Status, err)
+	}
+
+	if err := repo.AcceptWaitlistOffer(ctx, first.ID); err != nil {
+		t.Fatalf("AcceptWaitlistOffer: %v", err)
+	}
+	if reservation, err := repo.GetReservation(ctx, offer.ID); err != nil || reservation.Status != "Confirmed" {
+		t.Fatalf("offered reservation after accepting: got %v, %v, want it confirmed", reservation.Status, err)
+	}
+	if err := repo.AcceptWaitlistOffer(ctx, first.ID); !errors.Is(err, ErrNoOffer) {
+		t.Fatalf("AcceptWaitlistOffer twice: got %v, want ErrNoOffer", err)
+	}
+
+	// Only the waiting entry whose last day has passed expires
+	expired, err := repo.ExpireWaitlistEntries(ctx, "provider-1", "2000-01-03")
+	if err != nil || expired != 1 {
+		t.Fatalf("ExpireWaitlistEntries: got %d, %v, want 1", expired, err)
+	}
+	if entry, err := repo.GetWaitlistEntry(ctx, stale.ID); err != nil || entry.Status != models.WaitlistExpired {
+		t.Fatalf("stale entry: got %v, %v, want it expired", entry.Status, err)
+	}
+}
+
+func TestAddAvailabilityRuleException(t *testing.T) {
+	repo := NewGormRepository(openTestSQLite(t))
+	ctx := context.Background()
+	if err := repo.CreateProvider(ctx, models.Provider{ID: "provider-1", Active: true}); err != nil {
+		t.Fatalf("CreateProvider: %v", err)
+	}
+	rule := models.AvailabilityRule{ID: "rule-1", ProviderID: "provider-1", Weekdays: "Monday", StartTime: "09:00", EndTime: "10:00"}
+	if err := repo.CreateAvailabilityRule(ctx, &rule); err != nil {
+		t.Fatalf("CreateAvailabilityRule: %v", err)
+	}
+
+	// Two windows of the rule on the first day, one of them partly booked, and
+	// one on the next day
+	day := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 2)
+	var availabilities []models.Availability
+	var slots []models.Slot
+	for i, start := range []time.Time{day.Add(9 * time.Hour), day.Add(12 * time.Hour), day.Add(33 * time.Hour)} {
+		availability := models.Availability{ID: fmt.Sprintf("window-%d", i), ProviderID: "provider-1", RuleID: rule.ID, StartTime: start, EndTime: start.Add(time.Hour)}
+		availabilities = append(availabilities, availability)
+		for j := 0; j < 2; j++ {
+			slotStart := start.Add(time.Duration(j) * 30 * time.Minute)
+			slots = append(slots, models.Slot{
+				ID:             fmt.Sprintf("slot-%d-%d", i, j),
+				AvailabilityID: availability.ID,
+				StartTime:      slotStart,
+				EndTime:        slotStart.Add(30 * time.Minute),
+				Status:         "Available",
+			})
+		}
+	}
+	if err := repo.AddAvailabilityAndSlots(ctx, "provider-1", nil, availabilities, slots); err != nil {
+		t.Fatalf("AddAvailabilityAndSlots: %v", err)
+	}
+	if err := repo.ReserveSlot(ctx, newHold("client-1"), "slot-0-0", 1, 0); err != nil {
+		t.Fatalf("ReserveSlot: %v", err)
+	}
+
+	exception := models.AvailabilityRuleException{ID: "exception-1", RuleID: rule.ID, Date: day.Format(time.DateOnly)}
+	if err := repo.AddAvailabilityRuleException(ctx, exception, day, day.AddDate(0, 0, 1)); err != nil {
+		t.Fatalf("AddAvailabilityRuleException: %v", err)
+	}
+
+	// The booked window is kept without its free slot, the other window that
+	// day is gone, and the next day is untouched
+	stored, err := repo.GetAvailabilities(ctx, "provider-1", day, day.AddDate(0, 0, 2))
+	if err != nil {
+		t.Fatalf("GetAvailabilities: %v", err)
+	}
+	if len(stored) != 2 || stored[0].ID != "window-0" || stored[1].ID != "window-2" {
+		t.Fatalf("got windows %+v, want window-0 and window-2", stored)
+	}
+	for slotID, want := range map[string]bool{"slot-0-1": false, "slot-1-0": false, "slot-2-0": true, "slot-2-1": true} {
+		_, err := repo.GetAvailableSlot(ctx, slotID)
+		if got := err == nil; got != want {
+			t.Errorf("slot %s available: got %v, want %v", slotID, got, want)
+		}
+	}
+
+	saved, err := repo.GetAvailabilityRule(ctx, rule.ID)
+	if err != nil {
+		t.Fatalf("GetAvailabilityRule: %v", err)
+	}
+	if len(saved.Exceptions) != 1 || saved.Exceptions[0].Date != exception.Date {
+		t.Fatalf("got exceptions %+v, want %s", saved.Exceptions, exception.Date)
+	}
+
+	missing := models.AvailabilityRuleException{ID: "exception-2", RuleID: "rule-2", Date: exception.Date}
+	var notFound *NotFoundError
+	if err := repo.AddAvailabilityRuleException(ctx, missing, day, day.AddDate(0, 0, 1)); !errors.As(err, &notFound) {
+		t.Fatalf("AddAvailabilityRuleException for a missing rule: got %v, want NotFoundError", err)
+	}
+}
+
+func TestProviderLeadTimeKeepsZeroApartFromUnset(t *testing.T) {
+	repo := NewGormRepository(openTestSQLite(t))
+	ctx := context.Background()
+	zero := 0
+	if err := repo.CreateProvider(ctx, models.Provider{ID: "walk-in", MinLeadTimeMinutes: &zero, Active: true}); err != nil {
+		t.Fatalf("CreateProvider: %v", err)
+	}
+	if err := repo.CreateProvider(ctx, models.Provider{ID: "default", Active: true}); err != nil {
+		t.Fatalf("CreateProvider: %v", err)
+	}
+
+	walkIn, err := repo.GetProvider(ctx, "walk-in")
+	if err != nil {
+		t.Fatalf("GetProvider: %v", err)
+	}
+	if walkIn.MinLeadTimeMinutes == nil || *walkIn.MinLeadTimeMinutes != 0 {
+		t.Fatalf("got lead time %v, want 0", walkIn.MinLeadTimeMinutes)
+	}
+	unset, err := repo.GetProvider(ctx, "default")
+	if err != nil {
+		t.Fatalf("GetProvider: %v", err)
+	}
+	if unset.MinLeadTimeMinutes != nil {
+		t.Fatalf("got lead time %d, want none", *unset.MinLeadTimeMinutes)
+	}
+
+	// Clearing the lead time goes back to the default
+	walkIn.MinLeadTimeMinutes = nil
+	if err := repo.UpdateProvider(ctx, walkIn); err != nil {
+		t.Fatalf("UpdateProvider: %v", err)
+	}
+	walkIn, err = repo.GetProvider(ctx, "walk-in")
+	if err != nil {
+		t.Fatalf("GetProvider: %v", err)
+	}
+	if walkIn.MinLeadTimeMinutes != nil {
+		t.Fatalf("got lead time %d after clearing it, want none", *walkIn.MinLeadTimeMinutes)
+	}
+}
@@ -0,0 +1,169 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/manueldelreal/health-reservation-system/internal/models"
+)
+
+// Errors returned by a Repository. They may be wrapped in a NotFoundError or a
+// SlotError that says which record failed, so check for them with errors.Is.
+var (
+	// ErrNotFound is returned when a requested record does not exist.
+	ErrNotFound = errors.New("record not found")
+
+	// ErrAlreadyExists is returned when a record with the same ID or unique
+	// key is already stored.
+	ErrAlreadyExists = errors.New("record already exists")
+
+	// ErrSlotUnavailable is returned when a slot to claim does not exist, is
+	// already held or is being claimed by another request.
+	ErrSlotUnavailable = errors.New("slot is not available")
+
+	// ErrNotEnoughSlots is returned when the slots following the first one do
+	// not make a run long enough for the appointment.
+	ErrNotEnoughSlots = errors.New("not enough consecutive slots available")
+
+	// ErrAlreadyConfirmed is returned when confirming a confirmed reservation.
+	ErrAlreadyConfirmed = errors.New("reservation is already confirmed")
+
+	// ErrAvailabilityReserved is returned when removing the part of an
+	// availability window that reservations hold.
+	ErrAvailabilityReserved = errors.New("availability has reservations")
+
+	// ErrHoldExpired is returned when confirming, extending or rescheduling a
+	// hold that has expired but not been released yet.
+	ErrHoldExpired = errors.New("hold has expired")
+
+	// ErrHoldExtensionLimit is returned when extending a hold that has been
+	// extended the maximum number of times.
+	ErrHoldExtensionLimit = errors.New("hold cannot be extended any more")
+
+	// ErrNotWaiting is returned when offering a slot to a waitlist entry that
+	// is no longer waiting.
+	ErrNotWaiting = errors.New("waitlist entry is not waiting")
+
+	// ErrNoOffer is returned when accepting the offer of a waitlist entry that
+	// has no open offer.
+	ErrNoOffer = errors.New("waitlist entry has no open offer")
+)
+
+// NotFoundError names a record that does not exist. It matches ErrNotFound.
+type NotFoundError struct {
+	// Kind is the kind of record, such as "reservation".
+	Kind string
+	ID   string
+}
+
+func (e *NotFoundError) Error() string {
+	return e.Kind + " not found"
+}
+
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// SlotError names the slot a booking could not claim. It matches its Err,
+// ErrSlotUnavailable or ErrNotEnoughSlots.
+type SlotError struct {
+	SlotID string
+	Err    error
+}
+
+func (e *SlotError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *SlotError) Unwrap() error {
+	return e.Err
+}
+
+// AvailabilityMerge grows an existing availability window to new times and
+// moves the slots and reservations of the windows it absorbs into it.
+type AvailabilityMerge struct {
+	AvailabilityID string
+	StartTime      time.Time
+	EndTime        time.Time
+
+	// Absorbed are the IDs of the windows deleted in favor of this one.
+	Absorbed []string
+}
+
+// Repository is the storage backend of the reservation service. Operations that
+// touch several records are atomic.
+type Repository interface {
+	// Health
+	Ping(ctx context.Context) error
+
+	// Providers
+	CreateProvider(ctx context.Context, provider models.Provider) error
+	GetProvider(ctx context.Context, providerID string) (models.Provider, error)
+	UpdateProvider(ctx context.Context, provider models.Provider) error
+	DeactivateProvider(ctx context.Context, providerID string) error
+	ListProviders(ctx context.Context, includeInactive bool) ([]models.Provider, error)
+
+	// Clients
+	CreateClient(ctx context.Context, client models.Client) error
+	GetClient(ctx context.Context, clientID string) (models.Client, error)
+	UpdateClient(ctx context.Context, client models.Client) error
+
+	// Availability
+	FindAvailability(ctx context.Context, providerID string, startTime, endTime time.Time) (models.Availability, error)
+	GetAvailabilities(ctx context.Context, providerID string, startTime, endTime time.Time) ([]models.Availability, error)
+	AddAvailabilityAndSlots(ctx context.Context, providerID string, merges []AvailabilityMerge, availabilities []models.Availability, slots []models.Slot) error
+	ResizeAvailability(ctx context.Context, availabilityID string, startTime, endTime time.Time, cancellations []models.Cancellation) ([]models.Cancellation, error)
+	RemoveAvailability(ctx context.Context, availabilityID string, cancellations []models.Cancellation) ([]models.Cancellation, error)
+	CreateAvailabilityRule(ctx context.Context, rule *models.AvailabilityRule) error
+	GetAvailabilityRule(ctx context.Context, ruleID string) (models.AvailabilityRule, error)
+	GetAvailabilityRules(ctx context.Context, providerID string) ([]models.AvailabilityRule, error)
+	DeleteAvailabilityRule(ctx context.Context, ruleID string) error
+	AddAvailabilityRuleException(ctx context.Context, exception models.AvailabilityRuleException, dayStart, dayEnd time.Time) error
+
+	// Appointment types
+	CreateAppointmentType(ctx context.Context, appointmentType *models.AppointmentType) error
+	GetAppointmentType(ctx context.Context, appointmentTypeID, providerID string) (models.AppointmentType, error)
+	GetAppointmentTypes(ctx context.Context, providerID string) ([]models.AppointmentType, error)
+	DeleteAppointmentType(ctx context.Context, appointmentTypeID string) error
+
+	// Slots
+	GetAvailableSlot(ctx context.Context, slotID string) (models.Slot, error)
+	GetAvailableSlots(ctx context.Context, providerID string, date time.Time) ([]models.Slot, error)
+	CountAvailableSlots(ctx context.Context) (map[string]int, error)
+
+	// Reservations
+	GetReservation(ctx context.Context, reservationID string) (models.Reservation, error)
+	ReserveSlot(ctx context.Context, reservation models.Reservation, slotID string, count int, maxGap time.Duration) error
+	RescheduleReservation(ctx context.Context, reservationID, newSlotID string, count int, maxGap time.Duration) error
+	ConfirmReservation(ctx context.Context, reservationID string) error
+	ExtendHold(ctx context.Context, reservationID string, extension time.Duration, maxExtensions int) (models.Reservation, error)
+	ReplaceHoldToken(ctx context.Context, reservationID, tokenHash string) (models.Reservation, error)
+	CancelReservation(ctx context.Context, cancellation models.Cancellation) error
+	CleanupExpiredReservations(ctx context.Context) ([]models.Reservation, error)
+	GetReservationsByProvider(ctx context.Context, providerID string, date *time.Time) ([]models.Reservation, error)
+	GetReservationsByClient(ctx context.Context, clientID string, date *time.Time) ([]models.Reservation, error)
+	GetReservationsByAvailability(ctx context.Context, availabilityID string) ([]models.Reservation, error)
+
+	// Waitlist
+	CreateWaitlistEntry(ctx context.Context, entry models.WaitlistEntry) error
+	GetWaitlistEntry(ctx context.Context, entryID string) (models.WaitlistEntry, error)
+	GetWaitlistEntriesByClient(ctx context.Context, clientID string) ([]models.WaitlistEntry, error)
+	GetWaitingEntries(ctx context.Context, providerID string) ([]models.WaitlistEntry, error)
+	OfferWaitlistSlot(ctx context.Context, entryID string, reservation models.Reservation, slotID string, count int, maxGap time.Duration) error
+	AcceptWaitlistOffer(ctx context.Context, entryID string) error
+	CloseWaitlistOffer(ctx context.Context, reservationID, status string) error
+	ExpireWaitlistEntries(ctx context.Context, providerID, day string) (int, error)
+
+	// Idempotency keys
+	CreateIdempotencyKey(ctx context.Context, key models.IdempotencyKey) error
+	GetIdempotencyKey(ctx context.Context, keyID string) (models.IdempotencyKey, error)
+	SaveIdempotentResponse(ctx context.Context, keyID string, response []byte, expiresAt time.Time) error
+	DeleteIdempotencyKey(ctx context.Context, keyID string) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context) (int, error)
+}
+
+var (
+	_ Repository = (*GormRepository)(nil)
+	_ Repository = (*MemoryRepository)(nil)
+)
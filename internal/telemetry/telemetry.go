@@ -0,0 +1,153 @@
+// Package telemetry sets up OpenTelemetry tracing and traces the Twirp API.
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/twitchtv/twirp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ServiceName identifies the server in exported traces.
+const ServiceName = "health-reservation-system"
+
+const tracerName = "github.com/manueldelreal/health-reservation-system/internal/telemetry"
+
+// Exporters that Setup accepts.
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterFile   = "file"
+	ExporterOTLP   = "otlp"
+)
+
+// Options selects where spans are exported.
+type Options struct {
+	// Exporter is one of the Exporter constants.
+	Exporter string
+
+	// File is the file spans are appended to, as JSON, by ExporterFile.
+	File string
+
+	// Endpoint is the OTLP/HTTP collector URL, e.g. http://localhost:4318.
+	// When empty, the OTEL_EXPORTER_OTLP_* environment variables apply.
+	Endpoint string
+}
+
+// Setup installs the global tracer provider and the W3C trace context
+// propagator. The returned function flushes pending spans and must be called
+// on shutdown. With ExporterNone spans are still propagated but not exported.
+func Setup(ctx context.Context, opts Options) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var closer io.Closer
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch opts.Exporter {
+	case ExporterNone, "":
+		return func(context.Context) error { return nil }, nil
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case ExporterFile:
+		file, openErr := os.OpenFile(opts.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if openErr != nil {
+			return nil, fmt.Errorf("failed to open trace file: %w", openErr)
+		}
+		closer = file
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(file))
+	case ExporterOTLP:
+		var clientOpts []otlptracehttp.Option
+		if opts.Endpoint != "" {
+			clientOpts = append(clientOpts, otlptracehttp.WithEndpointURL(opts.Endpoint))
+		}
+		exporter, err = otlptracehttp.New(ctx, clientOpts...)
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q", opts.Exporter)
+	}
+	if err != nil {
+		if closer != nil {
+			closer.Close()
+		}
+		return nil, fmt.Errorf("failed to create trace exporter: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(ServiceName))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if closer != nil {
+			err = errors.Join(err, closer.Close())
+		}
+		return err
+	}, nil
+}
+
+// Middleware continues traces started by callers that send a traceparent
+// header.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// ServerHooks returns Twirp hooks that wrap every routed request in a server
+// span. The span records when the handler returned, so the time left after
+// that event is spent serializing and writing the response.
+func ServerHooks() *twirp.ServerHooks {
+	tracer := otel.Tracer(tracerName)
+	return &twirp.ServerHooks{
+		RequestRouted: func(ctx context.Context) (context.Context, error) {
+			service, _ := twirp.ServiceName(ctx)
+			method, _ := twirp.MethodName(ctx)
+			ctx, _ = tracer.Start(ctx, service+"/"+method,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					semconv.RPCSystemKey.String("twirp"),
+					semconv.RPCService(service),
+					semconv.RPCMethod(method),
+				),
+			)
+			return ctx, nil
+		},
+		ResponsePrepared: func(ctx context.Context) context.Context {
+			trace.SpanFromContext(ctx).AddEvent("response prepared")
+			return ctx
+		},
+		Error: func(ctx context.Context, err twirp.Error) context.Context {
+			span := trace.SpanFromContext(ctx)
+			span.SetAttributes(attribute.String("twirp.error_code", string(err.Code())))
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Msg())
+			return ctx
+		},
+		ResponseSent: func(ctx context.Context) {
+			span := trace.SpanFromContext(ctx)
+			if status, ok := twirp.StatusCode(ctx); ok {
+				if code, err := strconv.Atoi(status); err == nil {
+					span.SetAttributes(semconv.HTTPResponseStatusCode(code))
+				}
+			}
+			span.End()
+		},
+	}
+}
@@ -0,0 +1,130 @@
+// Package worker runs the server's periodic background jobs and stops them
+// cleanly on shutdown.
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/manueldelreal/health-reservation-system/internal/logging"
+)
+
+const tracerName = "github.com/manueldelreal/health-reservation-system/internal/worker"
+
+// Job is a task run right away and then on a fixed interval.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Supervisor runs jobs until it is stopped. A job that fails or panics is
+// logged and run again at its next interval.
+type Supervisor struct {
+	jobs   []Job
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu          sync.Mutex
+	lastSuccess map[string]time.Time
+}
+
+// NewSupervisor returns a Supervisor with no jobs.
+func NewSupervisor() *Supervisor {
+	return &Supervisor{lastSuccess: make(map[string]time.Time)}
+}
+
+// LastSuccess returns when a job last finished without error, or the zero time
+// if it never has.
+func (s *Supervisor) LastSuccess(name string) time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lastSuccess[name]
+}
+
+// Add registers a job. Jobs must be added before Start.
+func (s *Supervisor) Add(job Job) {
+	s.jobs = append(s.jobs, job)
+}
+
+// Start runs every job in its own goroutine until ctx is cancelled or Stop is
+// called.
+func (s *Supervisor) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(ctx, job)
+	}
+}
+
+// Stop tells the jobs to stop and waits for any run in progress to finish, or
+// for ctx to expire, whichever happens first.
+func (s *Supervisor) Stop(ctx context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// loop runs a job now and then on every tick until ctx is cancelled. A run in
+// progress is never interrupted by the ticker.
+func (s *Supervisor) loop(ctx context.Context, job Job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.runOnce(ctx, job)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runOnce runs a job a single time in a span of its own, logging its error or
+// panic. The run is not cancelled with ctx, so a transaction in progress can
+// commit while the server shuts down.
+func (s *Supervisor) runOnce(ctx context.Context, job Job) {
+	ctx, span := otel.Tracer(tracerName).Start(context.WithoutCancel(ctx), "job "+job.Name)
+	defer span.End()
+	ctx = logging.WithAttrs(ctx, slog.String("job", job.Name))
+
+	defer func() {
+		if r := recover(); r != nil {
+			slog.ErrorContext(ctx, "Job panicked", "panic", r)
+			span.SetStatus(codes.Error, "panic")
+		}
+	}()
+
+	if err := job.Run(ctx); err != nil {
+		slog.ErrorContext(ctx, "Job failed", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	s.lastSuccess[job.Name] = time.Now()
+	s.mu.Unlock()
+}